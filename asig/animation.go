@@ -0,0 +1,118 @@
+package asig
+
+import "github.com/bloeys/gglm/gglm"
+
+//An animation consists of key-frame data for a number of nodes. For
+//each node affected by the animation a separate series of data is given.
+type Animation struct {
+
+	/** The name of the animation. If the modeling package this data was
+	 *  exported from does support only a single animation channel, this
+	 *  name is usually empty (length is zero).
+	 */
+	Name string
+
+	//Duration of the animation in ticks
+	Duration float64
+
+	//Ticks per second. 0 if not specified in the imported file
+	TicksPerSecond float64
+
+	//The node animation channels. Each channel affects a single node
+	Channels []*NodeAnim
+
+	//The mesh animation channels. Each channel affects a single mesh
+	MeshChannels []*MeshAnim
+
+	//The morph mesh animation channels. Each channel affects a single mesh
+	MorphMeshChannels []*MeshMorphAnim
+}
+
+/** Describes the animation of a single node. The name specifies the
+ *  bone/node which is affected by this animation channel. The keyframes
+ *  are given in three separate series of values, one each for position,
+ *  rotation and scaling. The transformation matrix computed from these
+ *  values replaces the node's original transformation matrix at a
+ *  specific time.
+ *  This means all keys are absolute and not relative to the bone default pose.
+ *  The order in which the transformations are applied is
+ *  - as usual - scaling, rotation, translation.
+ *
+ *  All keys are returned in their correct, chronological order.
+ *  Duplicate keys don't pass the validation step. Most likely there
+ *  will be no negative time values, but they are not forbidden also ( so
+ *  implementations need to cope with them! )
+ */
+type NodeAnim struct {
+
+	//The name of the node affected by this animation. The node must exist and it must be unique
+	NodeName string
+
+	//The position keys of this animation channel. If there are position keys, there will also be at least one scaling and one rotation key
+	PositionKeys []VectorKey
+
+	//The rotation keys of this animation channel. If there are rotation keys, there will also be at least one scaling and one position key
+	RotationKeys []QuatKey
+
+	//The scaling keys of this animation channel. If there are scaling keys, there will also be at least one position and one rotation key
+	ScalingKeys []VectorKey
+
+	//Defines how the animation behaves before the first key is encountered
+	PreState AnimBehaviour
+
+	//Defines how the animation behaves after the last key was processed
+	PostState AnimBehaviour
+}
+
+//A time-value pair specifying a certain 3D vector for the given time
+type VectorKey struct {
+	Time  float64
+	Value gglm.Vec3
+}
+
+//A time-value pair specifying a rotation for the given time
+type QuatKey struct {
+	Time  float64
+	Value gglm.Quat
+}
+
+/** Describes vertex-based animations for a single mesh or a group of
+ *  meshes. Meshes carry the animation data for each frame in their
+ *  Mesh.AnimMeshes array. The purpose of MeshAnim is to
+ *  define keyframes linking each mesh attachment to a particular
+ *  point in time.
+ */
+type MeshAnim struct {
+
+	//Name of the mesh to be animated. Animated meshes need to be named (not necessarily uniquely,
+	//the name can basically serve as wild-card to select a group of meshes with similar animation setup)
+	Name string
+
+	Keys []MeshKey
+}
+
+//Binds an anim-mesh to a specific point in time
+type MeshKey struct {
+	Time float64
+
+	//Index into the Mesh.AnimMeshes array of the mesh referred to by MeshAnim.Name
+	Value uint
+}
+
+//Describes a morphing animation of a given mesh
+type MeshMorphAnim struct {
+
+	//Name of the mesh to be animated
+	Name string
+
+	Keys []MeshMorphKey
+}
+
+//Binds a morph anim mesh to a specific point in time
+type MeshMorphKey struct {
+	Time float64
+
+	//Indices into the Mesh.AnimMeshes array of the mesh referred to by MeshMorphAnim.Name, with Weights[i] being the weight of Values[i]
+	Values  []uint
+	Weights []float64
+}
@@ -2,10 +2,6 @@ package asig
 
 /*
 #cgo CFLAGS: -I .
-#cgo LDFLAGS: -L libs
-#cgo windows,amd64 LDFLAGS: -l assimp_windows_amd64
-#cgo darwin,amd64 LDFLAGS: -l assimp_darwin_amd64
-#cgo darwin,arm64 LDFLAGS: -l assimp_darwin_arm64
 
 #include "wrap.c"
 #include <stdlib.h>
@@ -13,6 +9,10 @@ package asig
 import "C"
 import (
 	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
 	"unsafe"
 
 	"github.com/bloeys/gglm/gglm"
@@ -41,9 +41,6 @@ type Node struct {
 	Metadata map[string]Metadata
 }
 
-type Animation struct {
-}
-
 type EmbeddedTexture struct {
 	cTex *C.struct_aiTexture
 
@@ -84,8 +81,9 @@ type EmbeddedTexture struct {
 	FormatHint string
 
 	/** Data of the texture.
-	 * Points to an array of Width * Height (or just len=Width if Height=0, which happens when data is compressed, like if the data is a PNG).
-	 * The format of the texture data is always ARGB8888.
+	 * Points to an array of Width * Height texels (or just len=Width bytes if Height=0, which happens when data is compressed, like if the data is a PNG).
+	 * The format of the texture data is always ARGB8888, which in memory is 4 bytes per texel in the order b,g,r,a.
+	 * Use EmbeddedTexture.Image to decode the data into an image.
 	 */
 	Data []byte
 
@@ -93,12 +91,6 @@ type EmbeddedTexture struct {
 	Filename     string
 }
 
-type Light struct {
-}
-
-type Camera struct {
-}
-
 type Metadata struct {
 	Type  MetadataType
 	Value interface{}
@@ -109,7 +101,7 @@ type MetadataEntry struct {
 }
 
 type Scene struct {
-	cScene *C.struct_aiScene
+	cScene *cSceneRef
 	Flags  SceneFlag
 
 	RootNode  *Node
@@ -131,35 +123,253 @@ type Scene struct {
 	 */
 	Textures []*EmbeddedTexture
 
-	// Animations []*Animation
-	// Lights     []*Light
-	// Cameras    []*Camera
+	Animations []*Animation
+	Lights     []*Light
+	Cameras    []*Camera
+
+	/** The global metadata assigned to the scene itself.
+	 *
+	 * This data contains global metadata which belongs to the scene like
+	 * unit-conversions, versions, vendors or other model-specific data. This
+	 * can be used to store format-specific metadata as well.
+	 * Accessors like Scene.UnitScaleFactor and Scene.UpAxis read the common keys.
+	 */
+	Metadata map[string]Metadata
+}
+
+//cSceneRef is the C scene shared by a Scene and the objects that reference its memory (like materials).
+//Once released, cs is nil and users get ErrSceneReleased instead of reading freed memory
+type cSceneRef struct {
+	lock sync.RWMutex
+	cs   *C.struct_aiScene
+
+	//Scenes built by Scene.Marshal are freed with aiFreeScene instead of aiReleaseImport
+	marshalled bool
+
+	//Set by Scene.ReleaseOnGC, and carried over when the scene gets a new C scene (e.g. by Scene.Marshal)
+	releaseOnGC bool
+}
+
+//setReleaseOnGC releases the C scene once neither the scene nor anything referencing its memory (like materials) is reachable
+func (r *cSceneRef) setReleaseOnGC() {
+	r.releaseOnGC = true
+	runtime.SetFinalizer(r, func(r *cSceneRef) { r.release() })
+}
+
+//release frees the C scene if it wasn't already, and returns true if it did
+func (r *cSceneRef) release() bool {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cs == nil {
+		return false
+	}
+
+	if r.marshalled {
+		C.aiFreeScene(r.cs)
+	} else {
+		C.aiReleaseImport(r.cs)
+	}
+
+	r.cs = nil
+	return true
+}
+
+//rlock read locks the scene, returning ErrSceneReleased if the scene was released (in which case it isn't locked).
+//runlock must be called once the C memory is no longer used
+func (r *cSceneRef) rlock() error {
+
+	if r == nil {
+		return ErrSceneReleased
+	}
+
+	r.lock.RLock()
+	if r.cs == nil {
+		r.lock.RUnlock()
+		return ErrSceneReleased
+	}
+
+	return nil
+}
+
+func (r *cSceneRef) runlock() {
+	r.lock.RUnlock()
 }
 
-func (s *Scene) releaseCResources() {
-	C.aiReleaseImport(s.cScene)
+//checkReleased returns ErrSceneReleased if the scene was released, without keeping it locked
+func (r *cSceneRef) checkReleased() error {
+
+	if err := r.rlock(); err != nil {
+		return err
+	}
+
+	r.runlock()
+	return nil
+}
+
+//Close releases the C memory of the scene. The Go data (meshes, nodes etc.) stays usable, but functions that
+//need the C scene (like the material getters) return ErrSceneReleased afterwards.
+//Calling Close more than once is safe, and calling the release function returned by the import functions is the same as calling Close.
+func (s *Scene) Close() error {
+
+	if s.cScene == nil || !s.cScene.release() {
+		return nil
+	}
+
+	for _, t := range s.Textures {
+		t.cTex = nil
+	}
+
+	return nil
+}
+
+//ReleaseOnGC releases the C memory of the scene once it is garbage collected without being closed. Materials keep the C memory alive,
+//so it is only released after both the scene and its materials are unreachable.
+//This is a safety net against leaks. Calling Close (or the release function) as soon as the scene isn't needed is still
+//preferred, as the Go garbage collector doesn't know how much C memory a scene holds and might collect it very late
+func (s *Scene) ReleaseOnGC() {
+
+	if s.cScene == nil {
+		return
+	}
+
+	s.cScene.setReleaseOnGC()
+}
+
+//IsReleased returns true if the C memory of the scene was released by Close
+func (s *Scene) IsReleased() bool {
+
+	if err := s.cScene.rlock(); err != nil {
+		return true
+	}
+	s.cScene.runlock()
+
+	return false
 }
 
 //
 // Assimp API
 //
 
+/** ImportFile imports the model file at path file and applies postProcessFlags to it.
+ *
+ * Imports can be called from many goroutines. Assimp's C API reports the error of a failed import through a global,
+ * so the import calls themselves (from files, memory and fs.FS) run one at a time, but parsing their scenes into Go doesn't.
+ * Calls that capture log messages (the WithWarnings imports and Scene.ApplyPostProcessing) don't run in parallel with
+ * any other asig call, and neither does anything else while a log function is set (see SetLogFunc).
+ */
 func ImportFile(file string, postProcessFlags PostProcess) (s *Scene, release func(), err error) {
+	return ImportFileWithOptions(file, postProcessFlags, nil)
+}
+
+//ImportFileWithOptions is like ImportFile but uses the import properties set in opts. opts can be nil.
+//It is serialized with other imports like ImportFile
+func ImportFileWithOptions(file string, postProcessFlags PostProcess, opts *ImportOptions) (s *Scene, release func(), err error) {
+	return importFile(file, postProcessFlags, opts, nil)
+}
+
+//ImportFileWithWarnings is like ImportFileWithOptions but also returns the warnings and errors assimp logged during the import.
+//Warnings are returned even if the import fails. It doesn't run in parallel with other asig calls (see ImportFile)
+func ImportFileWithWarnings(file string, postProcessFlags PostProcess, opts *ImportOptions) (s *Scene, warnings []LogMessage, release func(), err error) {
+
+	warnings = []LogMessage{}
+	s, release, err = importFile(file, postProcessFlags, opts, &warnings)
+	return s, warnings, release, err
+}
+
+func importFile(file string, postProcessFlags PostProcess, opts *ImportOptions, warnings *[]LogMessage) (s *Scene, release func(), err error) {
+
+	if err := libraryErr(); err != nil {
+		return nil, func() {}, err
+	}
 
 	cstr := C.CString(file)
 	defer C.free(unsafe.Pointer(cstr))
 
-	cs := C.aiImportFile(cstr, C.uint(postProcessFlags))
-	if cs == nil {
-		return nil, func() {}, getAiErr()
+	cProps := opts.toCPropStore()
+	if cProps != nil {
+		defer C.aiReleasePropertyStore(cProps)
+	}
+
+	cs, err := importScene(file, "", postProcessFlags, warnings, func() *C.struct_aiScene {
+		return C.aiImportFileExWithProperties(cstr, C.uint(postProcessFlags), nil, cProps)
+	})
+	if err != nil {
+		return nil, func() {}, err
 	}
 
 	s = parseScene(cs)
-	return s, func() { s.releaseCResources() }, nil
+	return s, func() { s.Close() }, nil
 }
 
-func getAiErr() error {
-	return errors.New("asig error: " + C.GoString(C.aiGetErrorString()))
+//ImportFromMemory imports a scene from a buffer holding the contents of a model file.
+//formatHint is the file extension of the format (e.g. "fbx" or "glb"), and helps assimp pick the right importer. It can be empty.
+//
+//Formats that spread their data across multiple files (e.g. OBJ with a separate MTL) can't be fully imported this way.
+//assimp takes the buffer length as an unsigned int, so buffers of 4 GiB or more return an error.
+//Imports from memory wait for other imports to finish, as described in ImportFile.
+func ImportFromMemory(data []byte, formatHint string, postProcessFlags PostProcess) (s *Scene, release func(), err error) {
+	return ImportFromMemoryWithOptions(data, formatHint, postProcessFlags, nil)
+}
+
+//ImportFromMemoryWithOptions is like ImportFromMemory but uses the import properties set in opts. opts can be nil.
+//It is serialized with other imports like ImportFile
+func ImportFromMemoryWithOptions(data []byte, formatHint string, postProcessFlags PostProcess, opts *ImportOptions) (s *Scene, release func(), err error) {
+	return importFromMemory(data, formatHint, postProcessFlags, opts, nil)
+}
+
+//ImportFromMemoryWithWarnings is like ImportFromMemoryWithOptions but also returns the warnings and errors assimp logged during the import.
+//Warnings are returned even if the import fails. It doesn't run in parallel with other asig calls (see ImportFile)
+func ImportFromMemoryWithWarnings(data []byte, formatHint string, postProcessFlags PostProcess, opts *ImportOptions) (s *Scene, warnings []LogMessage, release func(), err error) {
+
+	warnings = []LogMessage{}
+	s, release, err = importFromMemory(data, formatHint, postProcessFlags, opts, &warnings)
+	return s, warnings, release, err
+}
+
+func importFromMemory(data []byte, formatHint string, postProcessFlags PostProcess, opts *ImportOptions, warnings *[]LogMessage) (s *Scene, release func(), err error) {
+
+	if err := libraryErr(); err != nil {
+		return nil, func() {}, err
+	}
+
+	if err := checkImportBufferLen(len(data)); err != nil {
+		return nil, func() {}, err
+	}
+
+	cHint := C.CString(formatHint)
+	defer C.free(unsafe.Pointer(cHint))
+
+	cProps := opts.toCPropStore()
+	if cProps != nil {
+		defer C.aiReleasePropertyStore(cProps)
+	}
+
+	cs, err := importScene("", formatHint, postProcessFlags, warnings, func() *C.struct_aiScene {
+		return C.aiImportFileFromMemoryWithProperties((*C.char)(unsafe.Pointer(&data[0])), C.uint(len(data)), C.uint(postProcessFlags), cHint, cProps)
+	})
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	s = parseScene(cs)
+	return s, func() { s.Close() }, nil
+}
+
+//checkImportBufferLen returns an error if a buffer of length n can't be imported. Assimp takes the length as an unsigned int,
+//so larger buffers would be silently truncated
+func checkImportBufferLen(n int) error {
+
+	if n == 0 {
+		return errors.New("asig error: can not import from an empty buffer")
+	}
+
+	if uint64(n) > math.MaxUint32 {
+		return fmt.Errorf("asig error: can not import from a buffer of %d bytes, the maximum is %d bytes", n, uint64(math.MaxUint32))
+	}
+
+	return nil
 }
 
 //
@@ -168,12 +378,16 @@ func getAiErr() error {
 
 func parseScene(cs *C.struct_aiScene) *Scene {
 
-	s := &Scene{cScene: cs}
+	s := &Scene{cScene: &cSceneRef{cs: cs}}
 	s.Flags = SceneFlag(cs.mFlags)
 	s.RootNode = parseRootNode(cs.mRootNode)
 	s.Meshes = parseMeshes(cs.mMeshes, uint(cs.mNumMeshes))
-	s.Materials = parseMaterials(cs.mMaterials, uint(cs.mNumMaterials))
-	s.Textures = parseTextures(cs.mTextures, uint(s.cScene.mNumTextures))
+	s.Materials = parseMaterials(s.cScene, cs.mMaterials, uint(cs.mNumMaterials))
+	s.Textures = parseTextures(cs.mTextures, uint(cs.mNumTextures))
+	s.Animations = parseAnimations(cs.mAnimations, uint(cs.mNumAnimations))
+	s.Lights = parseLights(cs.mLights, uint(cs.mNumLights))
+	s.Cameras = parseCameras(cs.mCameras, uint(cs.mNumCameras))
+	s.Metadata = parseMetadata(cs.mMetaData)
 
 	return s
 }
@@ -292,32 +506,28 @@ func parseTextures(cTexIn **C.struct_aiTexture, count uint) []*EmbeddedTexture {
 	return textures
 }
 
-func parseTexels(cTexelsIn *C.struct_aiTexel, width, height uint) []byte {
-
-	//e.g. like a png. Otherwise we have pure color data
-	isCompressed := height == 0
+//texelByteCount returns the size of the data of an embedded texture. Compressed textures (height of 0, e.g. a png)
+//store their size in bytes in width, otherwise the data is width*height texels of 4 bytes each
+func texelByteCount(width, height uint) uint {
 
-	texelCount := width
-	if !isCompressed {
-		texelCount *= height
+	if height == 0 {
+		return width
 	}
-	texelCount /= 4
 
-	data := make([]byte, texelCount*4)
-	cTexels := unsafe.Slice(cTexelsIn, texelCount)
+	return width * height * 4
+}
 
-	for i := 0; i < int(texelCount); i++ {
+func parseTexels(cTexelsIn *C.struct_aiTexel, width, height uint) []byte {
 
-		//Order here is important as in a compressed format the order will represent arbitrary bytes, not colors.
-		//In aiTexel the struct field order is {b,g,r,a}, which puts A in the high bits and leads to a format of ARGB8888, therefore it must be maintained here
-		index := i * 4
-		data[index] = byte(cTexels[i].b)
-		data[index+1] = byte(cTexels[i].g)
-		data[index+2] = byte(cTexels[i].r)
-		data[index+3] = byte(cTexels[i].a)
+	if cTexelsIn == nil {
+		return []byte{}
 	}
 
-	return data
+	byteCount := texelByteCount(width, height)
+
+	//Memory order is important as in a compressed format the order will represent arbitrary bytes, not colors.
+	//In aiTexel the struct field order is {b,g,r,a}, which puts A in the high bits and leads to a format of ARGB8888, and copying the memory as is maintains that
+	return C.GoBytes(unsafe.Pointer(cTexelsIn), C.int(byteCount))
 }
 
 func parseMeshes(cm **C.struct_aiMesh, count uint) []*Mesh {
@@ -336,6 +546,7 @@ func parseMeshes(cm **C.struct_aiMesh, count uint) []*Mesh {
 		cmesh := cmeshes[i]
 		vertCount := uint(cmesh.mNumVertices)
 
+		m.PrimitiveTypes = PrimitiveType(cmesh.mPrimitiveTypes)
 		m.Vertices = parseVec3s(cmesh.mVertices, vertCount)
 		m.Normals = parseVec3s(cmesh.mNormals, vertCount)
 		m.Tangents = parseVec3s(cmesh.mTangents, vertCount)
@@ -354,7 +565,7 @@ func parseMeshes(cm **C.struct_aiMesh, count uint) []*Mesh {
 				continue
 			}
 
-			m.TexCoordChannelCount[j] = uint(cmeshes[j].mNumUVComponents[j])
+			m.TexCoordChannelCount[j] = uint(cmesh.mNumUVComponents[j])
 		}
 
 		//Faces
@@ -482,6 +693,259 @@ func parseBones(cbs **C.struct_aiBone, count uint) []*Bone {
 	return bones
 }
 
+func parseAnimations(cAnimsIn **C.struct_aiAnimation, count uint) []*Animation {
+
+	if cAnimsIn == nil {
+		return []*Animation{}
+	}
+
+	anims := make([]*Animation, count)
+	cAnims := unsafe.Slice(cAnimsIn, count)
+
+	for i := 0; i < int(count); i++ {
+
+		cAnim := cAnims[i]
+		anims[i] = &Animation{
+			Name:              parseAiString(cAnim.mName),
+			Duration:          float64(cAnim.mDuration),
+			TicksPerSecond:    float64(cAnim.mTicksPerSecond),
+			Channels:          parseNodeAnims(cAnim.mChannels, uint(cAnim.mNumChannels)),
+			MeshChannels:      parseMeshAnims(cAnim.mMeshChannels, uint(cAnim.mNumMeshChannels)),
+			MorphMeshChannels: parseMeshMorphAnims(cAnim.mMorphMeshChannels, uint(cAnim.mNumMorphMeshChannels)),
+		}
+	}
+
+	return anims
+}
+
+func parseNodeAnims(cNodeAnimsIn **C.struct_aiNodeAnim, count uint) []*NodeAnim {
+
+	if cNodeAnimsIn == nil {
+		return []*NodeAnim{}
+	}
+
+	nodeAnims := make([]*NodeAnim, count)
+	cNodeAnims := unsafe.Slice(cNodeAnimsIn, count)
+
+	for i := 0; i < int(count); i++ {
+
+		cna := cNodeAnims[i]
+		nodeAnims[i] = &NodeAnim{
+			NodeName:     parseAiString(cna.mNodeName),
+			PositionKeys: parseVectorKeys(cna.mPositionKeys, uint(cna.mNumPositionKeys)),
+			RotationKeys: parseQuatKeys(cna.mRotationKeys, uint(cna.mNumRotationKeys)),
+			ScalingKeys:  parseVectorKeys(cna.mScalingKeys, uint(cna.mNumScalingKeys)),
+			PreState:     AnimBehaviour(cna.mPreState),
+			PostState:    AnimBehaviour(cna.mPostState),
+		}
+	}
+
+	return nodeAnims
+}
+
+func parseVectorKeys(cKeysIn *C.struct_aiVectorKey, count uint) []VectorKey {
+
+	if cKeysIn == nil {
+		return []VectorKey{}
+	}
+
+	keys := make([]VectorKey, count)
+	cKeys := unsafe.Slice(cKeysIn, count)
+
+	for i := 0; i < int(count); i++ {
+		keys[i] = VectorKey{
+			Time:  float64(cKeys[i].mTime),
+			Value: parseVec3(&cKeys[i].mValue),
+		}
+	}
+
+	return keys
+}
+
+func parseQuatKeys(cKeysIn *C.struct_aiQuatKey, count uint) []QuatKey {
+
+	if cKeysIn == nil {
+		return []QuatKey{}
+	}
+
+	keys := make([]QuatKey, count)
+	cKeys := unsafe.Slice(cKeysIn, count)
+
+	for i := 0; i < int(count); i++ {
+		keys[i] = QuatKey{
+			Time:  float64(cKeys[i].mTime),
+			Value: parseQuat(&cKeys[i].mValue),
+		}
+	}
+
+	return keys
+}
+
+func parseMeshAnims(cMeshAnimsIn **C.struct_aiMeshAnim, count uint) []*MeshAnim {
+
+	if cMeshAnimsIn == nil {
+		return []*MeshAnim{}
+	}
+
+	meshAnims := make([]*MeshAnim, count)
+	cMeshAnims := unsafe.Slice(cMeshAnimsIn, count)
+
+	for i := 0; i < int(count); i++ {
+
+		cma := cMeshAnims[i]
+		meshAnims[i] = &MeshAnim{
+			Name: parseAiString(cma.mName),
+			Keys: make([]MeshKey, cma.mNumKeys),
+		}
+
+		if cma.mKeys == nil {
+			continue
+		}
+
+		cKeys := unsafe.Slice(cma.mKeys, cma.mNumKeys)
+		for j := 0; j < len(cKeys); j++ {
+			meshAnims[i].Keys[j] = MeshKey{
+				Time:  float64(cKeys[j].mTime),
+				Value: uint(cKeys[j].mValue),
+			}
+		}
+	}
+
+	return meshAnims
+}
+
+func parseMeshMorphAnims(cMorphAnimsIn **C.struct_aiMeshMorphAnim, count uint) []*MeshMorphAnim {
+
+	if cMorphAnimsIn == nil {
+		return []*MeshMorphAnim{}
+	}
+
+	morphAnims := make([]*MeshMorphAnim, count)
+	cMorphAnims := unsafe.Slice(cMorphAnimsIn, count)
+
+	for i := 0; i < int(count); i++ {
+
+		cma := cMorphAnims[i]
+		morphAnims[i] = &MeshMorphAnim{
+			Name: parseAiString(cma.mName),
+			Keys: make([]MeshMorphKey, cma.mNumKeys),
+		}
+
+		if cma.mKeys == nil {
+			continue
+		}
+
+		cKeys := unsafe.Slice(cma.mKeys, cma.mNumKeys)
+		for j := 0; j < len(cKeys); j++ {
+
+			valCount := uint(cKeys[j].mNumValuesAndWeights)
+			morphAnims[i].Keys[j] = MeshMorphKey{
+				Time:    float64(cKeys[j].mTime),
+				Values:  parseUInts(cKeys[j].mValues, valCount),
+				Weights: parseFloat64s(cKeys[j].mWeights, valCount),
+			}
+		}
+	}
+
+	return morphAnims
+}
+
+func parseLights(cLightsIn **C.struct_aiLight, count uint) []*Light {
+
+	if cLightsIn == nil {
+		return []*Light{}
+	}
+
+	lights := make([]*Light, count)
+	cLights := unsafe.Slice(cLightsIn, count)
+
+	for i := 0; i < int(count); i++ {
+
+		cl := cLights[i]
+		lights[i] = &Light{
+			Name:                 parseAiString(cl.mName),
+			Type:                 LightType(cl.mType),
+			Position:             parseVec3(&cl.mPosition),
+			Direction:            parseVec3(&cl.mDirection),
+			Up:                   parseVec3(&cl.mUp),
+			AttenuationConstant:  float32(cl.mAttenuationConstant),
+			AttenuationLinear:    float32(cl.mAttenuationLinear),
+			AttenuationQuadratic: float32(cl.mAttenuationQuadratic),
+			ColorDiffuse:         parseColor3(&cl.mColorDiffuse),
+			ColorSpecular:        parseColor3(&cl.mColorSpecular),
+			ColorAmbient:         parseColor3(&cl.mColorAmbient),
+			AngleInnerCone:       float32(cl.mAngleInnerCone),
+			AngleOuterCone:       float32(cl.mAngleOuterCone),
+			Size: gglm.Vec2{
+				Data: [2]float32{float32(cl.mSize.x), float32(cl.mSize.y)},
+			},
+		}
+	}
+
+	return lights
+}
+
+func parseCameras(cCamerasIn **C.struct_aiCamera, count uint) []*Camera {
+
+	if cCamerasIn == nil {
+		return []*Camera{}
+	}
+
+	cameras := make([]*Camera, count)
+	cCameras := unsafe.Slice(cCamerasIn, count)
+
+	for i := 0; i < int(count); i++ {
+
+		cc := cCameras[i]
+		cameras[i] = &Camera{
+			Name:          parseAiString(cc.mName),
+			Position:      parseVec3(&cc.mPosition),
+			Up:            parseVec3(&cc.mUp),
+			LookAt:        parseVec3(&cc.mLookAt),
+			HorizontalFOV: float32(cc.mHorizontalFOV),
+			ClipPlaneNear: float32(cc.mClipPlaneNear),
+			ClipPlaneFar:  float32(cc.mClipPlaneFar),
+			Aspect:        float32(cc.mAspect),
+		}
+	}
+
+	return cameras
+}
+
+func parseColor3(cc *C.struct_aiColor3D) gglm.Vec3 {
+
+	if cc == nil {
+		return gglm.Vec3{}
+	}
+
+	return gglm.Vec3{
+		Data: [3]float32{
+			float32(cc.r),
+			float32(cc.g),
+			float32(cc.b),
+		},
+	}
+}
+
+func parseQuat(cq *C.struct_aiQuaternion) gglm.Quat {
+
+	if cq == nil {
+		return *gglm.NewQuatId()
+	}
+
+	//gglm stores quaternions as (x,y,z,w)
+	return gglm.Quat{
+		Vec4: gglm.Vec4{
+			Data: [4]float32{
+				float32(cq.x),
+				float32(cq.y),
+				float32(cq.z),
+				float32(cq.w),
+			},
+		},
+	}
+}
+
 func parseMat4(cm4 *C.struct_aiMatrix4x4) *gglm.Mat4 {
 
 	if cm4 == nil {
@@ -498,6 +962,16 @@ func parseMat4(cm4 *C.struct_aiMatrix4x4) *gglm.Mat4 {
 	}
 }
 
+//toAiMat4 is the inverse of parseMat4
+func toAiMat4(m *gglm.Mat4) C.struct_aiMatrix4x4 {
+	return C.struct_aiMatrix4x4{
+		a1: C.ai_real(m.Data[0][0]), b1: C.ai_real(m.Data[0][1]), c1: C.ai_real(m.Data[0][2]), d1: C.ai_real(m.Data[0][3]),
+		a2: C.ai_real(m.Data[1][0]), b2: C.ai_real(m.Data[1][1]), c2: C.ai_real(m.Data[1][2]), d2: C.ai_real(m.Data[1][3]),
+		a3: C.ai_real(m.Data[2][0]), b3: C.ai_real(m.Data[2][1]), c3: C.ai_real(m.Data[2][2]), d3: C.ai_real(m.Data[2][3]),
+		a4: C.ai_real(m.Data[3][0]), b4: C.ai_real(m.Data[3][1]), c4: C.ai_real(m.Data[3][2]), d4: C.ai_real(m.Data[3][3]),
+	}
+}
+
 func parseVertexWeights(cWeights *C.struct_aiVertexWeight, count uint) []VertexWeight {
 
 	if cWeights == nil {
@@ -526,6 +1000,22 @@ func parseAiString(aiString C.struct_aiString) string {
 	return C.GoStringN(&aiString.data[0], C.int(aiString.length))
 }
 
+//toAiString converts a Go string to an aiString. Strings longer than MAXLEN-1 bytes are truncated
+func toAiString(s string) C.struct_aiString {
+
+	aiStr := C.struct_aiString{}
+	if len(s) > len(aiStr.data)-1 {
+		s = s[:len(aiStr.data)-1]
+	}
+
+	for i := 0; i < len(s); i++ {
+		aiStr.data[i] = C.char(s[i])
+	}
+
+	aiStr.length = C.ai_uint32(len(s))
+	return aiStr
+}
+
 func parseUInts(cui *C.uint, count uint) []uint {
 
 	if cui == nil {
@@ -541,6 +1031,21 @@ func parseUInts(cui *C.uint, count uint) []uint {
 	return uints
 }
 
+func parseFloat64s(cf *C.double, count uint) []float64 {
+
+	if cf == nil {
+		return []float64{}
+	}
+
+	floats := make([]float64, count)
+	cFloats := unsafe.Slice(cf, count)
+	for i := 0; i < len(cFloats); i++ {
+		floats[i] = float64(cFloats[i])
+	}
+
+	return floats
+}
+
 func parseVec3s(cv *C.struct_aiVector3D, count uint) []gglm.Vec3 {
 
 	if cv == nil {
@@ -586,7 +1091,7 @@ func parseColors(cv *C.struct_aiColor4D, count uint) []gglm.Vec4 {
 	return verts
 }
 
-func parseMaterials(cMatsIn **C.struct_aiMaterial, count uint) []*Material {
+func parseMaterials(cScene *cSceneRef, cMatsIn **C.struct_aiMaterial, count uint) []*Material {
 
 	mats := make([]*Material, count)
 	cMats := unsafe.Slice(cMatsIn, count)
@@ -594,6 +1099,7 @@ func parseMaterials(cMatsIn **C.struct_aiMaterial, count uint) []*Material {
 	for i := 0; i < int(count); i++ {
 
 		mats[i] = &Material{
+			cScene:           cScene,
 			cMat:             cMats[i],
 			Properties:       parseMatProperties(cMats[i].mProperties, uint(cMats[i].mNumProperties)),
 			AllocatedStorage: uint(cMats[i].mNumAllocated),
@@ -613,7 +1119,7 @@ func parseMatProperties(cMatPropsIn **C.struct_aiMaterialProperty, count uint) [
 		cmp := cMatProps[i]
 
 		matProps[i] = &MaterialProperty{
-			name:     parseAiString(cmp.mKey),
+			Name:     parseAiString(cmp.mKey),
 			Semantic: TextureType(cmp.mSemantic),
 			Index:    uint(cmp.mIndex),
 			TypeInfo: MatPropertyTypeInfo(cmp.mType),
@@ -0,0 +1,125 @@
+package asig
+
+import (
+	"errors"
+	"io/fs"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestTexelByteCount(t *testing.T) {
+
+	tests := []struct {
+		width, height uint
+		want          uint
+	}{
+		{width: 1234, height: 0, want: 1234},
+		{width: 0, height: 0, want: 0},
+		{width: 1, height: 1, want: 4},
+		{width: 2, height: 3, want: 24},
+		{width: 256, height: 128, want: 256 * 128 * 4},
+	}
+
+	for _, tt := range tests {
+		if got := texelByteCount(tt.width, tt.height); got != tt.want {
+			t.Errorf("texelByteCount(%d, %d) = %d, want %d", tt.width, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestCheckImportBufferLen(t *testing.T) {
+
+	if err := checkImportBufferLen(0); err == nil {
+		t.Error("checkImportBufferLen(0) succeeded, want an error for empty buffers")
+	}
+
+	if err := checkImportBufferLen(1); err != nil {
+		t.Errorf("checkImportBufferLen(1) = %v, want nil", err)
+	}
+
+	if strconv.IntSize == 32 {
+		t.Skip("buffers can't be larger than 4 GiB with 32-bit ints")
+	}
+
+	maxLen := uint64(math.MaxUint32)
+	if err := checkImportBufferLen(int(maxLen)); err != nil {
+		t.Errorf("checkImportBufferLen(%d) = %v, want nil", maxLen, err)
+	}
+
+	if err := checkImportBufferLen(int(maxLen + 1)); err == nil {
+		t.Errorf("checkImportBufferLen(%d) succeeded, want an error as assimp takes the length as an unsigned int", maxLen+1)
+	}
+}
+
+//Imports the testdata model with the linked (or loaded) assimp and checks the parsed scene,
+//and that everything needing the C scene fails after closing it
+func TestImportFileFixture(t *testing.T) {
+
+	requireLibrary(t)
+
+	s, release, err := ImportFile("testdata/tri.obj", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	if len(s.Meshes) != 1 {
+		t.Fatalf("got %d meshes, want 1", len(s.Meshes))
+	}
+
+	m := s.Meshes[0]
+	if len(m.Vertices) != 3 || m.Vertices[1].Data != [3]float32{1, 0, 0} {
+		t.Errorf("vertices = %v, want 3 vertices with (1, 0, 0) second", m.Vertices)
+	}
+
+	if len(m.Faces) != 1 || len(m.Faces[0].Indices) != 3 {
+		t.Errorf("faces = %v, want one triangle", m.Faces)
+	}
+
+	//The OBJ importer adds a default material before the ones of the MTL file
+	if m.MaterialIndex >= uint(len(s.Materials)) {
+		t.Fatalf("mesh material index %d is out of range of %d materials", m.MaterialIndex, len(s.Materials))
+	}
+	mat := s.Materials[m.MaterialIndex]
+
+	if name, err := GetMaterialString(mat, MatKeyName, TextureTypeNone, 0); err != nil || name != "Red" {
+		t.Errorf("material name = (%q, %v), want Red", name, err)
+	}
+
+	if diffuse, err := GetMaterialColor(mat, MatKeyColorDiffuse, TextureTypeNone, 0); err != nil || diffuse.Data != [4]float32{1, 0, 0, 1} {
+		t.Errorf("diffuse color = (%v, %v), want (1, 0, 0, 1)", diffuse, err)
+	}
+
+	missing, err := s.MissingTextures("testdata")
+	if err != nil || len(missing) != 1 || missing[0] != "missing.png" {
+		t.Errorf("MissingTextures() = (%v, %v), want [missing.png]", missing, err)
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.IsReleased() {
+		t.Error("IsReleased() = false after Close")
+	}
+
+	if _, err := GetMaterialColor(mat, MatKeyColorDiffuse, TextureTypeNone, 0); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("GetMaterialColor() after Close error = %v, want %v", err, ErrSceneReleased)
+	}
+
+	if _, err := s.MissingTextures("testdata"); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("MissingTextures() after Close error = %v, want %v", err, ErrSceneReleased)
+	}
+}
+
+//The kind of import errors is guessed from assimp's messages, so this checks the guess against a real assimp
+func TestImportFileMissing(t *testing.T) {
+
+	requireLibrary(t)
+
+	_, _, err := ImportFile("testdata/missing.obj", 0)
+	if !errors.Is(err, ErrFileNotFound) || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ImportFile() error = %v, want an error matching %v and %v", err, ErrFileNotFound, fs.ErrNotExist)
+	}
+}
@@ -0,0 +1,107 @@
+package asig
+
+import "github.com/bloeys/gglm/gglm"
+
+/** Helper structure to describe a virtual camera.
+ *
+ * Cameras have a representation in the node graph and can be animated.
+ * An important aspect is that the camera itself is also part of the
+ * scene-graph. This means, any values such as the look-at vector are not
+ * *absolute*, they're relative to the coordinate system defined
+ * by the node which corresponds to the camera. This allows for camera
+ * animations. For static cameras parameters like the 'look-at' or 'up' vectors
+ * are usually specified directly in Camera, but beware, they could also
+ * be encoded in the node transformation. Scene.CameraViewMatrix takes care of both.
+ *
+ * Some file formats (such as 3DS, ASE) export a "target point" -
+ * the point the camera is looking at (it can even be animated). Assimp
+ * writes the target point as a subnode of the camera's main node,
+ * called "<camName>.Target". However this is just additional information
+ * then the transformation tracks of the camera main node make the
+ * camera already look in the right direction.
+ */
+type Camera struct {
+
+	/** The name of the camera.
+	 *  There must be a node in the scenegraph with the same name.
+	 *  This node specifies the position of the camera in the scene
+	 *  hierarchy and can be animated. Use Scene.CameraNode to find it.
+	 */
+	Name string
+
+	//Position of the camera relative to the coordinate space defined by the corresponding node. The default value is 0|0|0
+	Position gglm.Vec3
+
+	//'Up' - vector of the camera coordinate system relative to the coordinate space defined by the corresponding node.
+	//The 'right' vector of the camera coordinate system is the cross product of the up and lookAt vectors.
+	//The default value is 0|1|0. The vector may be normalized, but it needn't.
+	Up gglm.Vec3
+
+	//'LookAt' - vector of the camera coordinate system relative to the coordinate space defined by the corresponding node.
+	//This is the viewing direction of the user. The default value is 0|0|1. The vector may be normalized, but it needn't.
+	LookAt gglm.Vec3
+
+	//Half horizontal field of view angle, in radians. The field of view angle is the angle between the center
+	//line of the screen and the left or right border. The default value is 1/4PI
+	HorizontalFOV float32
+
+	//Distance of the near clipping plane from the camera. The value may not be 0. The default value is 0.1
+	ClipPlaneNear float32
+
+	//Distance of the far clipping plane from the camera. The default value is 1000
+	ClipPlaneFar float32
+
+	//Screen aspect ratio (width/height). This value is 0 if the aspect ratio is not defined in the source file.
+	Aspect float32
+}
+
+//ProjectionMatrix returns an OpenGL style (right handed, clip space depth of -1 to 1) perspective projection matrix for the camera.
+//viewportAspect (width/height) is used if the camera doesn't define an aspect ratio (i.e. Aspect is 0).
+//
+//Assimp 5.0 (the version of the asig headers) has no orthographic cameras, so all cameras use a perspective projection
+func (c *Camera) ProjectionMatrix(viewportAspect float32) *gglm.Mat4 {
+
+	aspect := c.Aspect
+	if aspect == 0 {
+		aspect = viewportAspect
+	}
+
+	//Assimp gives us half the horizontal FOV while gglm needs the full vertical FOV
+	verticalFOV := 2 * gglm.Atan32(gglm.Tan32(c.HorizontalFOV)/aspect)
+	return gglm.Perspective(verticalFOV, aspect, c.ClipPlaneNear, c.ClipPlaneFar)
+}
+
+//CameraNode returns the node that positions the camera c in the scene, which is the node with the same name as the camera.
+//Returns nil if no such node exists
+func (s *Scene) CameraNode(c *Camera) *Node {
+	return s.FindNode(c.Name)
+}
+
+//CameraViewMatrix returns an OpenGL style (right handed, looking down -Z) view matrix for the camera c,
+//by applying the world transform of the camera's node to the camera's position, look-at and up vectors.
+//If the camera has no node then the camera vectors are used as is.
+func (s *Scene) CameraViewMatrix(c *Camera) *gglm.Mat4 {
+
+	world := gglm.NewMat4Id()
+	if n := s.CameraNode(c); n != nil {
+		world = n.WorldTransform()
+	}
+
+	pos := gglm.MulMat4Vec4(world, gglm.NewVec4(c.Position.X(), c.Position.Y(), c.Position.Z(), 1))
+	lookAt := gglm.MulMat4Vec4(world, gglm.NewVec4(c.LookAt.X(), c.LookAt.Y(), c.LookAt.Z(), 0))
+	up := gglm.MulMat4Vec4(world, gglm.NewVec4(c.Up.X(), c.Up.Y(), c.Up.Z(), 0))
+
+	eye := gglm.NewVec3(pos.X(), pos.Y(), pos.Z())
+	forward := gglm.NewVec3(lookAt.X(), lookAt.Y(), lookAt.Z()).Normalize()
+	right := gglm.Cross(forward, gglm.NewVec3(up.X(), up.Y(), up.Z())).Normalize()
+	trueUp := gglm.Cross(right, forward)
+
+	return &gglm.Mat4{
+		Data: [4][4]float32{
+			{right.X(), trueUp.X(), -forward.X(), 0},
+			{right.Y(), trueUp.Y(), -forward.Y(), 0},
+			{right.Z(), trueUp.Z(), -forward.Z(), 0},
+			{-gglm.DotVec3(right, eye), -gglm.DotVec3(trueUp, eye), gglm.DotVec3(forward, eye), 1},
+		},
+	}
+}
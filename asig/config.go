@@ -0,0 +1,164 @@
+package asig
+
+//ConfigKey is the name of an import property (an AI_CONFIG_XXX key in assimp/config.h).
+//Properties are set on an ImportOptions and passed to the ImportXWithOptions functions.
+type ConfigKey string
+
+//General
+const (
+	//Enables time measurements. Bool, default false
+	ConfigGlobMeasureTime ConfigKey = "GLOB_MEASURE_TIME"
+
+	//Skips generating dummy meshes for skeleton-only files. Bool, default false
+	ConfigImportNoSkeletonMeshes ConfigKey = "IMPORT_NO_SKELETON_MESHES"
+
+	//Maximum number of threads to use. Int, currently unused by assimp
+	ConfigGlobMultithreading ConfigKey = "GLOB_MULTITHREADING"
+
+	//A hint to assimp to favour speed against import quality. Bool, default false
+	ConfigFavourSpeed ConfigKey = "FAVOUR_SPEED"
+
+	//Scale applied to the scene by PostProcessGlobalScale. Float, default 1
+	ConfigGlobalScaleFactor ConfigKey = "GLOBAL_SCALE_FACTOR"
+
+	//Application scale factor. Float, default 1
+	ConfigAppScaleFactor ConfigKey = "APP_SCALE_FACTOR"
+
+	//Keyframe of vertex animations to import for all importers that don't have a specific override. Int, default 0
+	ConfigImportGlobalKeyframe ConfigKey = "IMPORT_GLOBAL_KEYFRAME"
+
+	//Removes bones that don't influence any vertex. Bool, default true
+	ConfigImportRemoveEmptyBones ConfigKey = "AI_CONFIG_IMPORT_REMOVE_EMPTY_BONES"
+)
+
+//Post processing
+const (
+	//Max bones per mesh for PostProcessSplitByBoneCount. Int, default 60
+	ConfigSplitByBoneCountMaxBones ConfigKey = "PP_SBBC_MAX_BONES"
+
+	//Max angle in degrees between tangents that are smoothed by PostProcessCalcTangentSpace. Float, default 45, max 175
+	ConfigCalcTangentsMaxSmoothingAngle ConfigKey = "PP_CT_MAX_SMOOTHING_ANGLE"
+
+	//Source UV channel for PostProcessCalcTangentSpace. Int, default 0
+	ConfigCalcTangentsTextureChannelIndex ConfigKey = "PP_CT_TEXTURE_CHANNEL_INDEX"
+
+	//Max angle in degrees between face normals that are smoothed by PostProcessGenSmoothNormals (aka crease angle). Float, default 175, max 175
+	ConfigGenSmoothNormalsMaxSmoothingAngle ConfigKey = "PP_GSN_MAX_SMOOTHING_ANGLE"
+
+	//Space separated list of material names kept by PostProcessRemoveRedundantMaterials. String
+	ConfigRemoveRedundantMaterialsExcludeList ConfigKey = "PP_RRM_EXCLUDE_LIST"
+
+	//Keeps the scene hierarchy with PostProcessPreTransformVertices. Bool, default false
+	ConfigPreTransformVerticesKeepHierarchy ConfigKey = "PP_PTV_KEEP_HIERARCHY"
+
+	//Normalizes all vertices into the [-1,1] range with PostProcessPreTransformVertices. Bool, default false
+	ConfigPreTransformVerticesNormalize ConfigKey = "PP_PTV_NORMALIZE"
+
+	//Applies ConfigPreTransformVerticesRootTransformation with PostProcessPreTransformVertices. Bool, default false
+	ConfigPreTransformVerticesAddRootTransformation ConfigKey = "PP_PTV_ADD_ROOT_TRANSFORMATION"
+
+	//Root transformation used by PostProcessPreTransformVertices. Matrix, default identity
+	ConfigPreTransformVerticesRootTransformation ConfigKey = "PP_PTV_ROOT_TRANSFORMATION"
+
+	//Removes degenerated primitives with PostProcessFindDegenerates instead of converting them to lines/points. Bool, default false
+	ConfigFindDegeneratesRemove ConfigKey = "PP_FD_REMOVE"
+
+	//Checks the area of triangles in PostProcessFindDegenerates. Bool, default true
+	ConfigFindDegeneratesCheckArea ConfigKey = "PP_FD_CHECKAREA"
+
+	//Space separated list of node names kept by PostProcessOptimizeGraph. String
+	ConfigOptimizeGraphExcludeList ConfigKey = "PP_OG_EXCLUDE_LIST"
+
+	//Max triangles per mesh for PostProcessSplitLargeMeshes. Int, default 1000000
+	ConfigSplitLargeMeshesTriangleLimit ConfigKey = "PP_SLM_TRIANGLE_LIMIT"
+
+	//Max vertices per mesh for PostProcessSplitLargeMeshes. Int, default 1000000
+	ConfigSplitLargeMeshesVertexLimit ConfigKey = "PP_SLM_VERTEX_LIMIT"
+
+	//Max bones affecting a single vertex for PostProcessLimitBoneWeights. Int, default 4
+	ConfigLimitBoneWeightsMaxWeights ConfigKey = "PP_LBW_MAX_WEIGHTS"
+
+	//Threshold for removing bones with PostProcessDebone. Float, default 1
+	ConfigDeboneThreshold ConfigKey = "PP_DB_THRESHOLD"
+
+	//Only removes bones with PostProcessDebone if all of them can be removed. Bool, default false
+	ConfigDeboneAllOrNone ConfigKey = "PP_DB_ALL_OR_NONE"
+
+	//Vertex cache size used by PostProcessImproveCacheLocality. Int, default 12
+	ConfigImproveCacheLocalityCacheSize ConfigKey = "PP_ICL_PTCACHE_SIZE"
+
+	//Components removed by PostProcessRemoveComponent, a combination of Component flags. Int, default 0
+	ConfigRemoveComponentFlags ConfigKey = "PP_RVC_FLAGS"
+
+	//Primitive types removed by PostProcessSortByPType, a combination of PrimitiveType flags. Int, default 0
+	ConfigSortByPTypeRemove ConfigKey = "PP_SBP_REMOVE"
+
+	//Epsilon used when comparing animation keys in PostProcessFindInvalidData. Float, default 0
+	ConfigFindInvalidDataAnimAccuracy ConfigKey = "PP_FID_ANIM_ACCURACY"
+
+	//Ignores texture coordinates in PostProcessFindInvalidData. Bool, default false
+	ConfigFindInvalidDataIgnoreTexCoords ConfigKey = "PP_FID_IGNORE_TEXTURECOORDS"
+
+	//UV transformations evaluated by PostProcessTransformUVCoords, a combination of UVTransformXYZ flags. Int, default UVTransformAll
+	ConfigTransformUVCoordsEvaluate ConfigKey = "PP_TUV_EVALUATE"
+)
+
+//Flags for ConfigTransformUVCoordsEvaluate
+const (
+	UVTransformScaling     = 0x1
+	UVTransformRotation    = 0x2
+	UVTransformTranslation = 0x4
+	UVTransformAll         = UVTransformScaling | UVTransformRotation | UVTransformTranslation
+)
+
+//FBX
+const (
+	ConfigImportFBXReadAllGeometryLayers        ConfigKey = "IMPORT_FBX_READ_ALL_GEOMETRY_LAYERS"
+	ConfigImportFBXReadAllMaterials             ConfigKey = "IMPORT_FBX_READ_ALL_MATERIALS"
+	ConfigImportFBXReadMaterials                ConfigKey = "IMPORT_FBX_READ_MATERIALS"
+	ConfigImportFBXReadTextures                 ConfigKey = "IMPORT_FBX_READ_TEXTURES"
+	ConfigImportFBXReadCameras                  ConfigKey = "IMPORT_FBX_READ_CAMERAS"
+	ConfigImportFBXReadLights                   ConfigKey = "IMPORT_FBX_READ_LIGHTS"
+	ConfigImportFBXReadAnimations               ConfigKey = "IMPORT_FBX_READ_ANIMATIONS"
+	ConfigImportFBXStrictMode                   ConfigKey = "IMPORT_FBX_STRICT_MODE"
+	ConfigImportFBXPreservePivots               ConfigKey = "IMPORT_FBX_PRESERVE_PIVOTS"
+	ConfigImportFBXOptimizeEmptyAnimationCurves ConfigKey = "IMPORT_FBX_OPTIMIZE_EMPTY_ANIMATION_CURVES"
+	ConfigImportFBXEmbeddedTexturesLegacyNaming ConfigKey = "AI_CONFIG_IMPORT_FBX_EMBEDDED_TEXTURES_LEGACY_NAMING"
+	ConfigFBXConvertToMeters                    ConfigKey = "AI_CONFIG_FBX_CONVERT_TO_M"
+)
+
+//Other importers
+const (
+	ConfigImportMDLColormap                 ConfigKey = "IMPORT_MDL_COLORMAP"
+	ConfigImportMD3Keyframe                 ConfigKey = "IMPORT_MD3_KEYFRAME"
+	ConfigImportMD2Keyframe                 ConfigKey = "IMPORT_MD2_KEYFRAME"
+	ConfigImportMDLKeyframe                 ConfigKey = "IMPORT_MDL_KEYFRAME"
+	ConfigImportMDCKeyframe                 ConfigKey = "IMPORT_MDC_KEYFRAME"
+	ConfigImportSMDKeyframe                 ConfigKey = "IMPORT_SMD_KEYFRAME"
+	ConfigImportUnrealKeyframe              ConfigKey = "IMPORT_UNREAL_KEYFRAME"
+	ConfigImportSMDLoadAnimationList        ConfigKey = "IMPORT_SMD_LOAD_ANIMATION_LIST"
+	ConfigImportACSeparateBackfaceCull      ConfigKey = "IMPORT_AC_SEPARATE_BFCULL"
+	ConfigImportACEvalSubdivision           ConfigKey = "IMPORT_AC_EVAL_SUBDIVISION"
+	ConfigImportUnrealHandleFlags           ConfigKey = "UNREAL_HANDLE_FLAGS"
+	ConfigImportTERMakeUVs                  ConfigKey = "IMPORT_TER_MAKE_UVS"
+	ConfigImportASEReconstructNormals       ConfigKey = "IMPORT_ASE_RECONSTRUCT_NORMALS"
+	ConfigImportMD3HandleMultipart          ConfigKey = "IMPORT_MD3_HANDLE_MULTIPART"
+	ConfigImportMD3SkinName                 ConfigKey = "IMPORT_MD3_SKIN_NAME"
+	ConfigImportMD3ShaderSrc                ConfigKey = "IMPORT_MD3_SHADER_SRC"
+	ConfigImportLWOOneLayerOnly             ConfigKey = "IMPORT_LWO_ONE_LAYER_ONLY"
+	ConfigImportMD5NoAnimAutoload           ConfigKey = "IMPORT_MD5_NO_ANIM_AUTOLOAD"
+	ConfigImportLWSAnimStart                ConfigKey = "IMPORT_LWS_ANIM_START"
+	ConfigImportLWSAnimEnd                  ConfigKey = "IMPORT_LWS_ANIM_END"
+	ConfigImportIRRAnimFPS                  ConfigKey = "IMPORT_IRR_ANIM_FPS"
+	ConfigImportOgreMaterialFile            ConfigKey = "IMPORT_OGRE_MATERIAL_FILE"
+	ConfigImportOgreTextureTypeFromFilename ConfigKey = "IMPORT_OGRE_TEXTURETYPE_FROM_FILENAME"
+	ConfigAndroidJNIAssimpManagerSupport    ConfigKey = "AI_CONFIG_ANDROID_JNI_ASSIMP_MANAGER_SUPPORT"
+	ConfigImportIFCSkipSpaceRepresentations ConfigKey = "IMPORT_IFC_SKIP_SPACE_REPRESENTATIONS"
+	ConfigImportIFCCustomTriangulation      ConfigKey = "IMPORT_IFC_CUSTOM_TRIANGULATION"
+	ConfigImportIFCSmoothingAngle           ConfigKey = "IMPORT_IFC_SMOOTHING_ANGLE"
+	ConfigImportIFCCylindricalTessellation  ConfigKey = "IMPORT_IFC_CYLINDRICAL_TESSELLATION"
+	ConfigImportColladaIgnoreUpDirection    ConfigKey = "IMPORT_COLLADA_IGNORE_UP_DIRECTION"
+	ConfigImportColladaUseColladaNames      ConfigKey = "IMPORT_COLLADA_USE_COLLADA_NAMES"
+	ConfigExportXFile64Bit                  ConfigKey = "EXPORT_XFILE_64BIT"
+	ConfigExportPointClouds                 ConfigKey = "EXPORT_POINT_CLOUDS"
+)
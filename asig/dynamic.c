@@ -0,0 +1,109 @@
+//go:build asig_dynamic
+// +build asig_dynamic
+
+// When built with the asig_dynamic tag assimp isn't linked. Instead we define every assimp function asig uses
+// as a stub that forwards to a function pointer, and fill the pointers with dlsym after asig_dl_open succeeds.
+// Stubs called before that return a zero value, except functions returning aiReturn which return aiReturn_FAILURE
+// (zero is aiReturn_SUCCESS, which would make callers read outputs that were never written).
+//
+// The last functions are the constructors of aiScene, aiNode and aiMaterial used by marshal.cpp, which are defined by the library
+// and not inline. Their stubs use the Itanium C++ ABI names, so the C++ code links against them like against the C API.
+
+#include <dlfcn.h>
+#include <stddef.h>
+#include "wrap.c"
+
+#define ASIG_FUNCS(X, XV, XR) \
+    X(const struct aiScene*, aiImportFileExWithProperties, (const char* pFile, unsigned int pFlags, struct aiFileIO* pFS, const struct aiPropertyStore* pProps), (pFile, pFlags, pFS, pProps)) \
+    X(const struct aiScene*, aiImportFileFromMemoryWithProperties, (const char* pBuffer, unsigned int pLength, unsigned int pFlags, const char* pHint, const struct aiPropertyStore* pProps), (pBuffer, pLength, pFlags, pHint, pProps)) \
+    X(struct aiPropertyStore*, aiCreatePropertyStore, (void), ()) \
+    XV(aiReleasePropertyStore, (struct aiPropertyStore* p), (p)) \
+    XV(aiSetImportPropertyInteger, (struct aiPropertyStore* store, const char* szName, int value), (store, szName, value)) \
+    XV(aiSetImportPropertyFloat, (struct aiPropertyStore* store, const char* szName, ai_real value), (store, szName, value)) \
+    XV(aiSetImportPropertyString, (struct aiPropertyStore* store, const char* szName, const struct aiString* st), (store, szName, st)) \
+    XV(aiSetImportPropertyMatrix, (struct aiPropertyStore* store, const char* szName, const struct aiMatrix4x4* mat), (store, szName, mat)) \
+    XV(aiReleaseImport, (const struct aiScene* pScene), (pScene)) \
+    X(const char*, aiGetErrorString, (void), ()) \
+    X(unsigned int, aiGetMaterialTextureCount, (const struct aiMaterial* pMat, enum aiTextureType type), (pMat, type)) \
+    XR(aiGetMaterialTexture, (const struct aiMaterial* mat, enum aiTextureType type, unsigned int index, struct aiString* path, enum aiTextureMapping* mapping, unsigned int* uvindex, ai_real* blend, enum aiTextureOp* op, enum aiTextureMapMode* mapmode, unsigned int* flags), (mat, type, index, path, mapping, uvindex, blend, op, mapmode, flags)) \
+    XR(aiGetMaterialColor, (const struct aiMaterial* pMat, const char* pKey, unsigned int type, unsigned int index, struct aiColor4D* pOut), (pMat, pKey, type, index, pOut)) \
+    XR(aiGetMaterialFloatArray, (const struct aiMaterial* pMat, const char* pKey, unsigned int type, unsigned int index, ai_real* pOut, unsigned int* pMax), (pMat, pKey, type, index, pOut, pMax)) \
+    XR(aiGetMaterialIntegerArray, (const struct aiMaterial* pMat, const char* pKey, unsigned int type, unsigned int index, int* pOut, unsigned int* pMax), (pMat, pKey, type, index, pOut, pMax)) \
+    XR(aiGetMaterialString, (const struct aiMaterial* pMat, const char* pKey, unsigned int type, unsigned int index, struct aiString* pOut), (pMat, pKey, type, index, pOut)) \
+    XR(aiGetMaterialUVTransform, (const struct aiMaterial* pMat, const char* pKey, unsigned int type, unsigned int index, struct aiUVTransform* pOut), (pMat, pKey, type, index, pOut)) \
+    XV(aiAttachLogStream, (const struct aiLogStream* stream), (stream)) \
+    XR(aiDetachLogStream, (const struct aiLogStream* stream), (stream)) \
+    XV(aiDetachAllLogStreams, (void), ()) \
+    XV(aiEnableVerboseLogging, (aiBool d), (d)) \
+    X(size_t, aiGetExportFormatCount, (void), ()) \
+    X(const struct aiExportFormatDesc*, aiGetExportFormatDescription, (size_t pIndex), (pIndex)) \
+    XV(aiReleaseExportFormatDescription, (const struct aiExportFormatDesc* desc), (desc)) \
+    XR(aiExportSceneEx, (const struct aiScene* pScene, const char* pFormatId, const char* pFileName, struct aiFileIO* pIO, unsigned int pPreprocessing), (pScene, pFormatId, pFileName, pIO, pPreprocessing)) \
+    X(const struct aiExportDataBlob*, aiExportSceneToBlob, (const struct aiScene* pScene, const char* pFormatId, unsigned int pPreprocessing), (pScene, pFormatId, pPreprocessing)) \
+    XV(aiReleaseExportBlob, (const struct aiExportDataBlob* pData), (pData)) \
+    XV(aiFreeScene, (const struct aiScene* pIn), (pIn)) \
+    X(const struct aiScene*, aiApplyPostProcessing, (const struct aiScene* pScene, unsigned int pFlags), (pScene, pFlags)) \
+    X(aiBool, aiIsExtensionSupported, (const char* szExtension), (szExtension)) \
+    XV(aiGetExtensionList, (struct aiString* szOut), (szOut)) \
+    X(size_t, aiGetImportFormatCount, (void), ()) \
+    X(const struct aiImporterDesc*, aiGetImportFormatDescription, (size_t pIndex), (pIndex)) \
+    X(unsigned int, aiGetVersionMajor, (void), ()) \
+    X(unsigned int, aiGetVersionMinor, (void), ()) \
+    X(unsigned int, aiGetVersionRevision, (void), ()) \
+    XV(_ZN7aiSceneC1Ev, (struct aiScene* scene), (scene)) \
+    XV(_ZN6aiNodeC1Ev, (struct aiNode* node), (node)) \
+    XV(_ZN10aiMaterialC1Ev, (struct aiMaterial* mat), (mat))
+
+#define ASIG_PTR(ret, name, params, args) static ret (*asig_p_##name) params;
+#define ASIG_PTR_VOID(name, params, args) static void (*asig_p_##name) params;
+#define ASIG_PTR_RETURN(name, params, args) ASIG_PTR(enum aiReturn, name, params, args)
+ASIG_FUNCS(ASIG_PTR, ASIG_PTR_VOID, ASIG_PTR_RETURN)
+
+#define ASIG_STUB(ret, name, params, args) \
+    ret name params { if (asig_p_##name == NULL) return (ret){0}; return asig_p_##name args; }
+#define ASIG_STUB_VOID(name, params, args) \
+    void name params { if (asig_p_##name != NULL) asig_p_##name args; }
+#define ASIG_STUB_RETURN(name, params, args) \
+    enum aiReturn name params { if (asig_p_##name == NULL) return aiReturn_FAILURE; return asig_p_##name args; }
+ASIG_FUNCS(ASIG_STUB, ASIG_STUB_VOID, ASIG_STUB_RETURN)
+
+static void* asig_lib = NULL;
+
+// asig_dl_open loads the library at path and resolves all functions. On failure NULL is returned and nothing is changed,
+// otherwise the returned handle must be passed to asig_dl_close if the library is not wanted.
+void* asig_dl_open(const char* path, const char** outErr) {
+
+    void* lib = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+    if (lib == NULL) {
+        *outErr = dlerror();
+        return NULL;
+    }
+
+#define ASIG_CHECK(ret, name, params, args) \
+    if (dlsym(lib, #name) == NULL) { *outErr = "missing symbol " #name; dlclose(lib); return NULL; }
+#define ASIG_CHECK_VOID(name, params, args) ASIG_CHECK(void, name, params, args)
+#define ASIG_CHECK_RETURN(name, params, args) ASIG_CHECK(enum aiReturn, name, params, args)
+    ASIG_FUNCS(ASIG_CHECK, ASIG_CHECK_VOID, ASIG_CHECK_RETURN)
+
+#define ASIG_LOAD(ret, name, params, args) *(void**)(&asig_p_##name) = dlsym(lib, #name);
+#define ASIG_LOAD_VOID(name, params, args) ASIG_LOAD(void, name, params, args)
+#define ASIG_LOAD_RETURN(name, params, args) ASIG_LOAD(enum aiReturn, name, params, args)
+    ASIG_FUNCS(ASIG_LOAD, ASIG_LOAD_VOID, ASIG_LOAD_RETURN)
+
+    asig_lib = lib;
+    return lib;
+}
+
+// asig_dl_close resets all functions to their stubs and closes the library
+void asig_dl_close(void) {
+
+#define ASIG_RESET(ret, name, params, args) asig_p_##name = NULL;
+#define ASIG_RESET_VOID(name, params, args) ASIG_RESET(void, name, params, args)
+#define ASIG_RESET_RETURN(name, params, args) ASIG_RESET(enum aiReturn, name, params, args)
+    ASIG_FUNCS(ASIG_RESET, ASIG_RESET_VOID, ASIG_RESET_RETURN)
+
+    if (asig_lib != NULL) {
+        dlclose(asig_lib);
+        asig_lib = NULL;
+    }
+}
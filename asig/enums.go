@@ -72,6 +72,44 @@ const (
 	SceneFlagAllowShared SceneFlag = 1 << 5
 )
 
+func (sf SceneFlag) String() string {
+
+	if sf == 0 {
+		return "None"
+	}
+
+	s := ""
+	if sf&SceneFlagIncomplete != 0 {
+		s += "Incomplete|"
+	}
+
+	if sf&SceneFlagValidated != 0 {
+		s += "Validated|"
+	}
+
+	if sf&SceneFlagValidationWarning != 0 {
+		s += "ValidationWarning|"
+	}
+
+	if sf&SceneFlagNonVerboseFormat != 0 {
+		s += "NonVerboseFormat|"
+	}
+
+	if sf&SceneFlagTerrain != 0 {
+		s += "Terrain|"
+	}
+
+	if sf&SceneFlagAllowShared != 0 {
+		s += "AllowShared|"
+	}
+
+	if sf&^(SceneFlagIncomplete|SceneFlagValidated|SceneFlagValidationWarning|SceneFlagNonVerboseFormat|SceneFlagTerrain|SceneFlagAllowShared) != 0 {
+		s += "Unknown|"
+	}
+
+	return s[:len(s)-1]
+}
+
 //aiGetErrorString specifies the types of primitives that can be present in a mesh
 type PrimitiveType int32
 
@@ -82,6 +120,36 @@ const (
 	PrimitiveTypePolygon  = 1 << 3
 )
 
+func (pt PrimitiveType) String() string {
+
+	if pt == 0 {
+		return "None"
+	}
+
+	s := ""
+	if pt&PrimitiveTypePoint != 0 {
+		s += "Point|"
+	}
+
+	if pt&PrimitiveTypeLine != 0 {
+		s += "Line|"
+	}
+
+	if pt&PrimitiveTypeTriangle != 0 {
+		s += "Triangle|"
+	}
+
+	if pt&PrimitiveTypePolygon != 0 {
+		s += "Polygon|"
+	}
+
+	if pt&^(PrimitiveTypePoint|PrimitiveTypeLine|PrimitiveTypeTriangle|PrimitiveTypePolygon) != 0 {
+		s += "Unknown|"
+	}
+
+	return s[:len(s)-1]
+}
+
 //MorphMethod specifies the Supported methods of mesh morphing
 type MorphMethod int32
 
@@ -286,6 +354,264 @@ func (tp TextureType) String() string {
 	}
 }
 
+//TextureOp defines how the Nth texture of a specific type is combined with the result of all previous layers
+type TextureOp int32
+
+const (
+	//T = T1 * T2
+	TextureOpMultiply TextureOp = 0
+	//T = T1 + T2
+	TextureOpAdd TextureOp = 1
+	//T = T1 - T2
+	TextureOpSubtract TextureOp = 2
+	//T = T1 / T2
+	TextureOpDivide TextureOp = 3
+	//T = (T1 + T2) - (T1 * T2)
+	TextureOpSmoothAdd TextureOp = 4
+	//T = T1 + (T2-0.5)
+	TextureOpSignedAdd TextureOp = 5
+)
+
+func (op TextureOp) String() string {
+
+	switch op {
+	case TextureOpMultiply:
+		return "Multiply"
+	case TextureOpAdd:
+		return "Add"
+	case TextureOpSubtract:
+		return "Subtract"
+	case TextureOpDivide:
+		return "Divide"
+	case TextureOpSmoothAdd:
+		return "SmoothAdd"
+	case TextureOpSignedAdd:
+		return "SignedAdd"
+	default:
+		return "Unknown"
+	}
+}
+
+//TextureMapMode defines how UV coordinates outside the [0...1] range are handled
+type TextureMapMode int32
+
+const (
+	//A texture coordinate u|v is translated to u%1|v%1
+	TextureMapModeWrap TextureMapMode = 0
+
+	//Texture coordinates outside [0...1] are clamped to the nearest valid value
+	TextureMapModeClamp TextureMapMode = 1
+
+	//A texture coordinate u|v becomes u%1|v%1 if (u-(u%1))%2 is zero and 1-(u%1)|1-(v%1) otherwise
+	TextureMapModeMirror TextureMapMode = 2
+
+	//If the texture coordinates for a pixel are outside [0...1] the texture is not applied to that pixel
+	TextureMapModeDecal TextureMapMode = 3
+)
+
+func (mm TextureMapMode) String() string {
+
+	switch mm {
+	case TextureMapModeWrap:
+		return "Wrap"
+	case TextureMapModeClamp:
+		return "Clamp"
+	case TextureMapModeMirror:
+		return "Mirror"
+	case TextureMapModeDecal:
+		return "Decal"
+	default:
+		return "Unknown"
+	}
+}
+
+/** TextureMapping defines how the mapping coords for a texture are generated.
+ *
+ * Real-time applications typically require full UV coordinates, so the use of
+ * PostProcessGenUVCoords is highly recommended. It generates proper UV channels
+ * for non-UV mapped objects, as long as an accurate description how the mapping
+ * should look like (e.g spherical) is given.
+ */
+type TextureMapping int32
+
+const (
+	//The mapping coordinates are taken from an UV channel
+	TextureMappingUV TextureMapping = 0
+	//Spherical mapping
+	TextureMappingSphere TextureMapping = 1
+	//Cylindrical mapping
+	TextureMappingCylinder TextureMapping = 2
+	//Cubic mapping
+	TextureMappingBox TextureMapping = 3
+	//Planar mapping
+	TextureMappingPlane TextureMapping = 4
+	//Undefined mapping. Have fun
+	TextureMappingOther TextureMapping = 5
+)
+
+func (tm TextureMapping) String() string {
+
+	switch tm {
+	case TextureMappingUV:
+		return "UV"
+	case TextureMappingSphere:
+		return "Sphere"
+	case TextureMappingCylinder:
+		return "Cylinder"
+	case TextureMappingBox:
+		return "Box"
+	case TextureMappingPlane:
+		return "Plane"
+	case TextureMappingOther:
+		return "Other"
+	default:
+		return "Unknown"
+	}
+}
+
+//TextureFlags are additional bit flags of a texture
+type TextureFlags uint32
+
+const (
+	//The texture's color values have to be inverted (component-wise 1-n)
+	TextureFlagsInvert TextureFlags = 1 << 0
+
+	/** Explicit request to the application to process the alpha channel of the texture.
+	 *
+	 * Mutually exclusive with TextureFlagsIgnoreAlpha. These flags are set if the library can say for sure
+	 * that the alpha channel is used/is not used. If the model format does not define this, it is left to
+	 * the application to decide whether the texture alpha channel - if any - is evaluated or not.
+	 */
+	TextureFlagsUseAlpha TextureFlags = 1 << 1
+
+	//Explicit request to the application to ignore the alpha channel of the texture. Mutually exclusive with TextureFlagsUseAlpha
+	TextureFlagsIgnoreAlpha TextureFlags = 1 << 2
+)
+
+func (tf TextureFlags) String() string {
+
+	if tf == 0 {
+		return "None"
+	}
+
+	s := ""
+	if tf&TextureFlagsInvert != 0 {
+		s += "Invert|"
+	}
+
+	if tf&TextureFlagsUseAlpha != 0 {
+		s += "UseAlpha|"
+	}
+
+	if tf&TextureFlagsIgnoreAlpha != 0 {
+		s += "IgnoreAlpha|"
+	}
+
+	if tf&^(TextureFlagsInvert|TextureFlagsUseAlpha|TextureFlagsIgnoreAlpha) != 0 {
+		s += "Unknown|"
+	}
+
+	return s[:len(s)-1]
+}
+
+/** ShadingMode defines all shading models supported by the library.
+ *
+ * The list of shading modes has been taken from Blender. See Blender documentation for more information.
+ * The API does not distinguish between "specular" and "diffuse" shaders (thus the specular term for
+ * diffuse shading models like Oren-Nayar remains undefined).
+ * Again, this value is just a hint. Assimp tries to select the shader whose most common implementation
+ * matches the original rendering results of the 3D modeller which wrote a particular model as closely as possible.
+ */
+type ShadingMode int32
+
+const (
+	//Flat shading. Shading is done on per-face base, diffuse only. Also known as 'faceted shading'
+	ShadingModeFlat ShadingMode = 1
+
+	//Simple Gouraud shading
+	ShadingModeGouraud ShadingMode = 2
+
+	//Phong-Shading
+	ShadingModePhong ShadingMode = 3
+
+	//Phong-Blinn-Shading
+	ShadingModeBlinn ShadingMode = 4
+
+	//Toon-Shading per pixel. Also known as 'comic' shader
+	ShadingModeToon ShadingMode = 5
+
+	//OrenNayar-Shading per pixel. Extension to standard Lambertian shading, taking the roughness of the material into account
+	ShadingModeOrenNayar ShadingMode = 6
+
+	//Minnaert-Shading per pixel. Extension to standard Lambertian shading, taking the "darkness" of the material into account
+	ShadingModeMinnaert ShadingMode = 7
+
+	//CookTorrance-Shading per pixel. Special shader for metallic surfaces
+	ShadingModeCookTorrance ShadingMode = 8
+
+	//No shading at all. Constant light influence of 1.0
+	ShadingModeNoShading ShadingMode = 9
+
+	//Fresnel shading
+	ShadingModeFresnel ShadingMode = 10
+)
+
+func (sm ShadingMode) String() string {
+
+	switch sm {
+	case ShadingModeFlat:
+		return "Flat"
+	case ShadingModeGouraud:
+		return "Gouraud"
+	case ShadingModePhong:
+		return "Phong"
+	case ShadingModeBlinn:
+		return "Blinn"
+	case ShadingModeToon:
+		return "Toon"
+	case ShadingModeOrenNayar:
+		return "OrenNayar"
+	case ShadingModeMinnaert:
+		return "Minnaert"
+	case ShadingModeCookTorrance:
+		return "CookTorrance"
+	case ShadingModeNoShading:
+		return "NoShading"
+	case ShadingModeFresnel:
+		return "Fresnel"
+	default:
+		return "Unknown"
+	}
+}
+
+/** BlendMode defines how the final color value of a pixel is computed, based on the previous color
+ * at that pixel and the new color value from the material. The blend formula is:
+ *	SourceColor * SourceBlend + DestColor * DestBlend
+ * where DestColor is the previous color in the framebuffer at this position and SourceColor is the
+ * material color before the transparency calculation.
+ */
+type BlendMode int32
+
+const (
+	//SourceColor*SourceAlpha + DestColor*(1-SourceAlpha)
+	BlendModeDefault BlendMode = 0
+
+	//SourceColor*1 + DestColor*1
+	BlendModeAdditive BlendMode = 1
+)
+
+func (bm BlendMode) String() string {
+
+	switch bm {
+	case BlendModeDefault:
+		return "Default"
+	case BlendModeAdditive:
+		return "Additive"
+	default:
+		return "Unknown"
+	}
+}
+
 type MatPropertyTypeInfo int32
 
 const (
@@ -328,3 +654,279 @@ const (
 	MetadataTypeVec3    MetadataType = 6
 	MetadataTypeMAX     MetadataType = 7
 )
+
+//Component specifies parts of the scene that can be removed with PostProcessRemoveComponent.
+//See ImportOptions.SetRemoveComponents
+type Component uint32
+
+const (
+	ComponentNormals Component = 0x2
+
+	//Tangents and bitangents go always together
+	ComponentTangentsAndBitangents Component = 0x4
+
+	//All color sets. Use ComponentColorsN to specify the N'th set
+	ComponentColors Component = 0x8
+
+	//All texture UV sets. Use ComponentTexCoordsN to specify the N'th set
+	ComponentTexCoords Component = 0x10
+
+	/** Removes all bone weights from all meshes.
+	 * The scenegraph nodes corresponding to the bones are NOT removed.
+	 * use the PostProcessOptimizeGraph step to do this
+	 */
+	ComponentBoneWeights Component = 0x20
+
+	/** Removes all node animations (Scene.Animations).
+	 * The corresponding scenegraph nodes are NOT removed.
+	 * use the PostProcessOptimizeGraph step to do this
+	 */
+	ComponentAnimations Component = 0x40
+
+	//Removes all embedded textures (Scene.Textures)
+	ComponentTextures Component = 0x80
+
+	/** Removes all light sources (Scene.Lights).
+	 * The corresponding scenegraph nodes are NOT removed.
+	 * use the PostProcessOptimizeGraph step to do this
+	 */
+	ComponentLights Component = 0x100
+
+	/** Removes all cameras (Scene.Cameras).
+	 * The corresponding scenegraph nodes are NOT removed.
+	 * use the PostProcessOptimizeGraph step to do this
+	 */
+	ComponentCameras Component = 0x200
+
+	//Removes all meshes (Scene.Meshes)
+	ComponentMeshes Component = 0x400
+
+	//Removes all materials. One default material will be generated, so len(Scene.Materials) will be 1
+	ComponentMaterials Component = 0x800
+)
+
+//ComponentColorsN removes the color set n (0 <= n < MaxColorSets)
+func ComponentColorsN(n uint) Component {
+	return 1 << (n + 20)
+}
+
+//ComponentTexCoordsN removes the UV set n. Like in assimp, only sets 0 to 6 can be selected this way (0 <= n < MaxTexCoords-1)
+func ComponentTexCoordsN(n uint) Component {
+	return 1 << (n + 25)
+}
+
+//AnimBehaviour defines how an animation channel behaves outside the defined time range (see NodeAnim.PreState and NodeAnim.PostState)
+type AnimBehaviour int32
+
+const (
+	//The value from the default node transformation is taken
+	AnimBehaviourDefault AnimBehaviour = 0x0
+
+	//The nearest key value is used without interpolation
+	AnimBehaviourConstant AnimBehaviour = 0x1
+
+	//The value of the nearest two keys is linearly extrapolated for the current time value
+	AnimBehaviourLinear AnimBehaviour = 0x2
+
+	/** The animation is repeated.
+	 *
+	 *  If the animation key go from n to m and the current
+	 *  time is t, use the value at (t-n) % (|m-n|).
+	 */
+	AnimBehaviourRepeat AnimBehaviour = 0x3
+)
+
+func (ab AnimBehaviour) String() string {
+
+	switch ab {
+	case AnimBehaviourDefault:
+		return "Default"
+	case AnimBehaviourConstant:
+		return "Constant"
+	case AnimBehaviourLinear:
+		return "Linear"
+	case AnimBehaviourRepeat:
+		return "Repeat"
+	default:
+		return "Unknown"
+	}
+}
+
+//LightType enumerates all supported types of light sources
+type LightType int32
+
+const (
+	LightTypeUndefined LightType = 0x0
+
+	//A directional light source has a well-defined direction but is infinitely far away.
+	//That's quite a good approximation for sun light.
+	LightTypeDirectional LightType = 0x1
+
+	//A point light source has a well-defined position in space but no direction - it emits light in all directions.
+	//A normal bulb is a point light.
+	LightTypePoint LightType = 0x2
+
+	//A spot light source emits light in a specific angle. It has a position and a direction it is pointing to.
+	//A good example for a spot light is a light spot in sport arenas.
+	LightTypeSpot LightType = 0x3
+
+	//The generic light level of the world, including the bounces of all other light sources.
+	//Typically, there's at most one ambient light in a scene.
+	//This light type doesn't have a valid position, direction, or other properties, just a color.
+	LightTypeAmbient LightType = 0x4
+
+	//An area light is a rectangle with predefined size that uniformly emits light from one of its sides.
+	//The position is center of the rectangle and direction is its normal vector.
+	LightTypeArea LightType = 0x5
+)
+
+func (lt LightType) String() string {
+
+	switch lt {
+	case LightTypeUndefined:
+		return "Undefined"
+	case LightTypeDirectional:
+		return "Directional"
+	case LightTypePoint:
+		return "Point"
+	case LightTypeSpot:
+		return "Spot"
+	case LightTypeAmbient:
+		return "Ambient"
+	case LightTypeArea:
+		return "Area"
+	default:
+		return "Unknown"
+	}
+}
+
+//Axis is one of the coordinate axes, as used in the scene metadata (see Scene.UpAxis)
+type Axis int32
+
+const (
+	AxisX Axis = 0
+	AxisY Axis = 1
+	AxisZ Axis = 2
+)
+
+func (a Axis) String() string {
+
+	switch a {
+	case AxisX:
+		return "X"
+	case AxisY:
+		return "Y"
+	case AxisZ:
+		return "Z"
+	default:
+		return "Unknown"
+	}
+}
+
+//AlphaMode is how the alpha channel of a PBRMaterial is interpreted, using the glTF definitions
+type AlphaMode int32
+
+const (
+	//Alpha is ignored and the material is fully opaque
+	AlphaModeOpaque AlphaMode = iota
+	//Fragments with alpha below PBRMaterial.AlphaCutoff are discarded, the rest are opaque
+	AlphaModeMask
+	//Alpha is used to blend the material with the background
+	AlphaModeBlend
+)
+
+func (am AlphaMode) String() string {
+
+	switch am {
+	case AlphaModeOpaque:
+		return "Opaque"
+	case AlphaModeMask:
+		return "Mask"
+	case AlphaModeBlend:
+		return "Blend"
+	default:
+		return "Unknown"
+	}
+}
+
+//LogSeverity is the severity of a message logged by assimp. Severities are ordered, so for example
+//'severity >= LogSeverityWarn' matches warnings and errors
+type LogSeverity int32
+
+const (
+	LogSeverityDebug LogSeverity = iota
+	LogSeverityInfo
+	LogSeverityWarn
+	LogSeverityError
+)
+
+func (ls LogSeverity) String() string {
+
+	switch ls {
+	case LogSeverityDebug:
+		return "Debug"
+	case LogSeverityInfo:
+		return "Info"
+	case LogSeverityWarn:
+		return "Warn"
+	case LogSeverityError:
+		return "Error"
+	default:
+		return "Unknown"
+	}
+}
+
+//ImporterFlags indicate some characteristics common to many importers
+type ImporterFlags uint32
+
+const (
+	//There is a textual encoding of the file format, and it is supported
+	ImporterFlagsSupportTextFlavour ImporterFlags = 1 << 0
+
+	//There is a binary encoding of the file format, and it is supported
+	ImporterFlagsSupportBinaryFlavour ImporterFlags = 1 << 1
+
+	//There is a compressed encoding of the file format, and it is supported
+	ImporterFlagsSupportCompressedFlavour ImporterFlags = 1 << 2
+
+	//The importer reads only a very particular subset of the file format. This happens commonly for
+	//declarative or procedural formats which cannot easily be mapped to a Scene
+	ImporterFlagsLimitedSupport ImporterFlags = 1 << 3
+
+	//The importer is highly experimental and should be used with care
+	ImporterFlagsExperimental ImporterFlags = 1 << 4
+)
+
+func (imf ImporterFlags) String() string {
+
+	if imf == 0 {
+		return "None"
+	}
+
+	s := ""
+	if imf&ImporterFlagsSupportTextFlavour != 0 {
+		s += "SupportTextFlavour|"
+	}
+
+	if imf&ImporterFlagsSupportBinaryFlavour != 0 {
+		s += "SupportBinaryFlavour|"
+	}
+
+	if imf&ImporterFlagsSupportCompressedFlavour != 0 {
+		s += "SupportCompressedFlavour|"
+	}
+
+	if imf&ImporterFlagsLimitedSupport != 0 {
+		s += "LimitedSupport|"
+	}
+
+	if imf&ImporterFlagsExperimental != 0 {
+		s += "Experimental|"
+	}
+
+	if imf&^(ImporterFlagsSupportTextFlavour|ImporterFlagsSupportBinaryFlavour|ImporterFlagsSupportCompressedFlavour|ImporterFlagsLimitedSupport|ImporterFlagsExperimental) != 0 {
+		s += "Unknown|"
+	}
+
+	return s[:len(s)-1]
+}
@@ -0,0 +1,131 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include "wrap.c"
+*/
+import "C"
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"sync"
+)
+
+//Sentinel errors that an ImportError can be checked against with errors.Is
+var (
+	//No importer supports the file format
+	ErrUnsupportedFormat = errors.New("unsupported file format")
+
+	//The file (or a file it references) couldn't be opened. An ImportError with this kind also matches fs.ErrNotExist
+	ErrFileNotFound = errors.New("file not found")
+
+	//The imported scene failed validation (e.g. with PostProcessValidateDataStructure)
+	ErrValidationFailed = errors.New("scene validation failed")
+
+	ErrOutOfMemory = errors.New("out of memory")
+)
+
+//ErrSceneReleased is returned by functions that need the C memory of a scene after the scene was closed (see Scene.Close)
+var ErrSceneReleased = errors.New("asig error: scene was released")
+
+//ImportError is returned when assimp fails to import a scene
+type ImportError struct {
+	//File is empty for imports from memory
+	File       string
+	FormatHint string
+	Flags      PostProcess
+
+	//Message is the error reported by assimp
+	Message string
+
+	//Kind is one of the ErrXXX sentinels, or nil if the error doesn't match any of them.
+	//Assimp only reports import errors as text, so Kind is a best-effort guess from Message (see importErrKind)
+	Kind error
+}
+
+func (e *ImportError) Error() string {
+
+	if e.File == "" {
+		return "asig error: failed to import from memory (format hint '" + e.FormatHint + "'): " + e.Message
+	}
+
+	return "asig error: failed to import '" + e.File + "': " + e.Message
+}
+
+func (e *ImportError) Unwrap() error {
+	return e.Kind
+}
+
+func (e *ImportError) Is(target error) bool {
+	return target == fs.ErrNotExist && e.Kind == ErrFileNotFound
+}
+
+//importLock is held from the start of an import until its error message is read, because assimp's C API keeps
+//the error message of the last failed import in a global, and a concurrent import could overwrite it before it is read.
+//It is only taken after logLock (see lockLogging).
+var importLock sync.Mutex
+
+//importScene runs importFunc and returns its scene, or an ImportError with the message of this import if it failed.
+//If warnings isn't nil, the warnings and errors logged during the import are appended to it
+func importScene(file, formatHint string, flags PostProcess, warnings *[]LogMessage, importFunc func() *C.struct_aiScene) (*C.struct_aiScene, error) {
+
+	//Log streams are global, so to only get the messages of this import nothing else may run assimp code meanwhile
+	if warnings != nil {
+
+		logLock.Lock()
+		defer logLock.Unlock()
+
+		ls := attachLogStream(func(lm LogMessage) {
+			if lm.Severity >= LogSeverityWarn {
+				*warnings = append(*warnings, lm)
+			}
+		})
+		defer ls.detach()
+	} else {
+		unlock := lockLogging()
+		defer unlock()
+	}
+
+	importLock.Lock()
+	cs := importFunc()
+
+	msg := ""
+	if cs == nil {
+		msg = C.GoString(C.aiGetErrorString())
+	}
+	importLock.Unlock()
+
+	if cs != nil {
+		return cs, nil
+	}
+
+	return nil, &ImportError{
+		File:       file,
+		FormatHint: formatHint,
+		Flags:      flags,
+		Message:    msg,
+		Kind:       importErrKind(msg),
+	}
+}
+
+//importErrKind maps an assimp error message to one of the ErrXXX sentinels.
+//Assimp has no error codes, so this is a best-effort fallback that looks for parts of the (English) messages of assimp 5.0.
+//Messages it doesn't recognize, including ones reworded by other assimp versions, get a nil kind
+func importErrKind(msg string) error {
+
+	lowerMsg := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lowerMsg, "no suitable reader found"):
+		return ErrUnsupportedFormat
+	case strings.Contains(lowerMsg, "unable to open file"), strings.Contains(lowerMsg, "failed to open file"):
+		return ErrFileNotFound
+	case strings.Contains(lowerMsg, "validation failed"):
+		return ErrValidationFailed
+	case strings.Contains(lowerMsg, "bad_alloc"), strings.Contains(lowerMsg, "out of memory"):
+		return ErrOutOfMemory
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package asig
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+)
+
+func TestImportErrKind(t *testing.T) {
+
+	tests := []struct {
+		msg  string
+		want error
+	}{
+		{msg: "No suitable reader found for the file format of file \"a.xyz\".", want: ErrUnsupportedFormat},
+		{msg: "NO SUITABLE READER FOUND", want: ErrUnsupportedFormat},
+		{msg: "Unable to open file \"a.obj\".", want: ErrFileNotFound},
+		{msg: "Failed to open file a.fbx.", want: ErrFileNotFound},
+		{msg: "Validation failed: aiMesh::mFaces[0].mIndices[2] is out of range", want: ErrValidationFailed},
+		{msg: "std::bad_alloc", want: ErrOutOfMemory},
+		{msg: "Out of memory while loading", want: ErrOutOfMemory},
+		{msg: "OBJ: Invalid face indice", want: nil},
+		{msg: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := importErrKind(tt.msg); got != tt.want {
+			t.Errorf("importErrKind(%q) = %v, want %v", tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestImportError(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		err       *ImportError
+		wantMsg   string
+		wantIs    []error
+		wantNotIs []error
+	}{
+		{
+			name:      "file not found",
+			err:       &ImportError{File: "a.obj", Message: "Unable to open file \"a.obj\".", Kind: ErrFileNotFound},
+			wantMsg:   "asig error: failed to import 'a.obj': Unable to open file \"a.obj\".",
+			wantIs:    []error{ErrFileNotFound, fs.ErrNotExist},
+			wantNotIs: []error{ErrUnsupportedFormat},
+		},
+		{
+			name:      "unsupported from memory",
+			err:       &ImportError{FormatHint: "xyz", Message: "No suitable reader found", Kind: ErrUnsupportedFormat},
+			wantMsg:   "asig error: failed to import from memory (format hint 'xyz'): No suitable reader found",
+			wantIs:    []error{ErrUnsupportedFormat},
+			wantNotIs: []error{ErrFileNotFound, fs.ErrNotExist},
+		},
+		{
+			name:      "unknown kind",
+			err:       &ImportError{File: "a.fbx", Message: "broken"},
+			wantMsg:   "asig error: failed to import 'a.fbx': broken",
+			wantNotIs: []error{ErrFileNotFound, ErrUnsupportedFormat, ErrValidationFailed, ErrOutOfMemory, fs.ErrNotExist},
+		},
+	}
+
+	for _, tt := range tests {
+
+		if got := tt.err.Error(); got != tt.wantMsg {
+			t.Errorf("%s: Error() = %q, want %q", tt.name, got, tt.wantMsg)
+		}
+
+		var err error = tt.err
+		for _, target := range tt.wantIs {
+			if !errors.Is(err, target) {
+				t.Errorf("%s: errors.Is(err, %v) = false, want true", tt.name, target)
+			}
+		}
+
+		for _, target := range tt.wantNotIs {
+			if errors.Is(err, target) {
+				t.Errorf("%s: errors.Is(err, %v) = true, want false", tt.name, target)
+			}
+		}
+
+		var importErr *ImportError
+		if !errors.As(err, &importErr) || importErr != tt.err {
+			t.Errorf("%s: errors.As didn't return the ImportError", tt.name)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include <stdlib.h>
+#include "wrap.c"
+*/
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+//ExportFormatDesc describes a file format assimp can export to
+type ExportFormatDesc struct {
+	//A short string ID to uniquely identify the export format (e.g. "collada" or "obj"). Used as the formatID of Scene.Export
+	ID string
+
+	//A short description of the file format to present to users
+	Description string
+
+	//Recommended file extension for the exported file in lower case
+	FileExtension string
+}
+
+//ExportBlob is one of the files produced by Scene.ExportToBlobs
+type ExportBlob struct {
+	//Name is empty for the main file. Other files (e.g. the material file of an OBJ) are usually named by their file extension (e.g. "mtl")
+	Name string
+	Data []byte
+}
+
+//ExportFormats returns all the file formats supported by the assimp library in use
+func ExportFormats() ([]*ExportFormatDesc, error) {
+
+	if err := libraryErr(); err != nil {
+		return nil, err
+	}
+
+	count := int(C.aiGetExportFormatCount())
+	formats := make([]*ExportFormatDesc, 0, count)
+	for i := 0; i < count; i++ {
+
+		cDesc := C.aiGetExportFormatDescription(C.size_t(i))
+		if cDesc == nil {
+			continue
+		}
+
+		formats = append(formats, &ExportFormatDesc{
+			ID:            C.GoString(cDesc.id),
+			Description:   C.GoString(cDesc.description),
+			FileExtension: C.GoString(cDesc.fileExtension),
+		})
+
+		C.aiReleaseExportFormatDescription(cDesc)
+	}
+
+	return formats, nil
+}
+
+//Export writes the scene to the file at path using the export format with formatID (see ExportFormats).
+//postProcessFlags are applied to a copy of the scene before exporting it, and the scene itself is not changed.
+//
+//Exporters may write extra files next to path, like the material file of an OBJ.
+//Exports run in parallel with other asig calls, unless a log function is set (see SetLogFunc)
+func (s *Scene) Export(formatID, path string, postProcessFlags PostProcess) error {
+
+	if err := checkExportFormat(formatID); err != nil {
+		return err
+	}
+
+	//logLock is always taken before the scene lock (see logLock)
+	unlock := lockLogging()
+	defer unlock()
+
+	if err := s.cScene.rlock(); err != nil {
+		return err
+	}
+	defer s.cScene.runlock()
+
+	cFormatID := C.CString(formatID)
+	defer C.free(unsafe.Pointer(cFormatID))
+
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	status := aiReturn(C.aiExportSceneEx(s.cScene.cs, cFormatID, cPath, nil, C.uint(postProcessFlags)))
+
+	if status != aiReturnSuccess {
+		return exportErr(formatID, path, status)
+	}
+
+	return nil
+}
+
+//ExportToBlobs is like Export but returns the exported files in memory instead of writing them to disk.
+//The first blob is always the main file
+func (s *Scene) ExportToBlobs(formatID string, postProcessFlags PostProcess) ([]*ExportBlob, error) {
+
+	if err := checkExportFormat(formatID); err != nil {
+		return nil, err
+	}
+
+	unlock := lockLogging()
+	defer unlock()
+
+	if err := s.cScene.rlock(); err != nil {
+		return nil, err
+	}
+	defer s.cScene.runlock()
+
+	cFormatID := C.CString(formatID)
+	defer C.free(unsafe.Pointer(cFormatID))
+
+	cBlob := C.aiExportSceneToBlob(s.cScene.cs, cFormatID, C.uint(postProcessFlags))
+
+	if cBlob == nil {
+		return nil, exportErr(formatID, "", aiReturnFailure)
+	}
+	defer C.aiReleaseExportBlob(cBlob)
+
+	blobs := []*ExportBlob{}
+	for b := cBlob; b != nil; b = b.next {
+		blobs = append(blobs, &ExportBlob{
+			Name: parseAiString(b.name),
+			Data: C.GoBytes(b.data, C.int(b.size)),
+		})
+	}
+
+	return blobs, nil
+}
+
+func checkExportFormat(formatID string) error {
+
+	if err := libraryErr(); err != nil {
+		return err
+	}
+
+	formats, err := ExportFormats()
+	if err != nil {
+		return err
+	}
+
+	for _, f := range formats {
+		if f.ID == formatID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("asig error: can not export to format '%s': %w", formatID, ErrUnsupportedFormat)
+}
+
+func exportErr(formatID, path string, status aiReturn) error {
+
+	target := "blob"
+	if path != "" {
+		target = "'" + path + "'"
+	}
+
+	if status == aiReturnOutofMemory {
+		return fmt.Errorf("asig error: failed to export %s with format '%s': %w", target, formatID, ErrOutOfMemory)
+	}
+
+	return fmt.Errorf("asig error: failed to export %s with format '%s'", target, formatID)
+}
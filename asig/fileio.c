@@ -0,0 +1,68 @@
+// C side of the aiFileIO implementation in fs.go. Assimp calls these callbacks, which forward
+// to exported Go functions using the handles stored in the UserData fields.
+
+#include <stdint.h>
+#include <stdlib.h>
+#include "wrap.c"
+#include "_cgo_export.h"
+
+static size_t asig_fs_read(struct aiFile* f, char* buf, size_t size, size_t count) {
+    return asigFSRead((uintptr_t)f->UserData, buf, size, count);
+}
+
+static size_t asig_fs_write(struct aiFile* f, const char* buf, size_t size, size_t count) {
+    // fs.FS is read only
+    return 0;
+}
+
+static size_t asig_fs_tell(struct aiFile* f) {
+    return asigFSTell((uintptr_t)f->UserData);
+}
+
+static size_t asig_fs_size(struct aiFile* f) {
+    return asigFSSize((uintptr_t)f->UserData);
+}
+
+static enum aiReturn asig_fs_seek(struct aiFile* f, size_t offset, enum aiOrigin origin) {
+    return (enum aiReturn)asigFSSeek((uintptr_t)f->UserData, offset, (int)origin);
+}
+
+static void asig_fs_flush(struct aiFile* f) {
+}
+
+static struct aiFile* asig_fs_open(struct aiFileIO* io, const char* path, const char* mode) {
+
+    uintptr_t fileHandle = asigFSOpen((uintptr_t)io->UserData, (char*)path, (char*)mode);
+    if (fileHandle == 0) {
+        return NULL;
+    }
+
+    struct aiFile* f = malloc(sizeof(struct aiFile));
+    f->ReadProc = asig_fs_read;
+    f->WriteProc = asig_fs_write;
+    f->TellProc = asig_fs_tell;
+    f->FileSizeProc = asig_fs_size;
+    f->SeekProc = asig_fs_seek;
+    f->FlushProc = asig_fs_flush;
+    f->UserData = (aiUserData)fileHandle;
+    return f;
+}
+
+static void asig_fs_close(struct aiFileIO* io, struct aiFile* f) {
+
+    if (f == NULL) {
+        return;
+    }
+
+    asigFSClose((uintptr_t)f->UserData);
+    free(f);
+}
+
+struct aiFileIO* asig_new_fileio(uintptr_t fsHandle) {
+
+    struct aiFileIO* io = malloc(sizeof(struct aiFileIO));
+    io->OpenProc = asig_fs_open;
+    io->CloseProc = asig_fs_close;
+    io->UserData = (aiUserData)fsHandle;
+    return io;
+}
@@ -0,0 +1,172 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include <stdint.h>
+#include <stdlib.h>
+#include "wrap.c"
+
+struct aiFileIO* asig_new_fileio(uintptr_t fsHandle);
+*/
+import "C"
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"runtime/cgo"
+	"strings"
+	"unsafe"
+)
+
+//fsFile is an fs.FS file opened by assimp. The whole file is read on open, because files returned
+//by an fs.FS aren't required to support seeking (e.g. files from a zip.Reader), while assimp needs it.
+type fsFile struct {
+	r *bytes.Reader
+}
+
+//ImportFS imports the file called name from fsys (e.g. an embed.FS, a *zip.Reader or an fstest.MapFS).
+//Any other files needed by the importer, like the MTL of an OBJ or the textures and buffers of a glTF,
+//are also opened through fsys relative to name.
+//
+//name must be a valid fs.FS path as defined by fs.ValidPath.
+//fsys is read during the import call, which runs one import at a time (see ImportFile), so slow file systems delay imports on other goroutines too.
+func ImportFS(fsys fs.FS, name string, postProcessFlags PostProcess) (s *Scene, release func(), err error) {
+	return ImportFSWithOptions(fsys, name, postProcessFlags, nil)
+}
+
+//ImportFSWithOptions is like ImportFS but uses the import properties set in opts. opts can be nil.
+//It is serialized with other imports like ImportFile
+func ImportFSWithOptions(fsys fs.FS, name string, postProcessFlags PostProcess, opts *ImportOptions) (s *Scene, release func(), err error) {
+	return importFS(fsys, name, postProcessFlags, opts, nil)
+}
+
+//ImportFSWithWarnings is like ImportFSWithOptions but also returns the warnings and errors assimp logged during the import.
+//Warnings are returned even if the import fails. It doesn't run in parallel with other asig calls (see ImportFile)
+func ImportFSWithWarnings(fsys fs.FS, name string, postProcessFlags PostProcess, opts *ImportOptions) (s *Scene, warnings []LogMessage, release func(), err error) {
+
+	warnings = []LogMessage{}
+	s, release, err = importFS(fsys, name, postProcessFlags, opts, &warnings)
+	return s, warnings, release, err
+}
+
+func importFS(fsys fs.FS, name string, postProcessFlags PostProcess, opts *ImportOptions, warnings *[]LogMessage) (s *Scene, release func(), err error) {
+
+	if err := libraryErr(); err != nil {
+		return nil, func() {}, err
+	}
+
+	if !fs.ValidPath(name) {
+		return nil, func() {}, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	fsHandle := cgo.NewHandle(fsys)
+	defer fsHandle.Delete()
+
+	cIO := C.asig_new_fileio(C.uintptr_t(fsHandle))
+	defer C.free(unsafe.Pointer(cIO))
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	cProps := opts.toCPropStore()
+	if cProps != nil {
+		defer C.aiReleasePropertyStore(cProps)
+	}
+
+	cs, err := importScene(name, "", postProcessFlags, warnings, func() *C.struct_aiScene {
+		return C.aiImportFileExWithProperties(cName, C.uint(postProcessFlags), cIO, cProps)
+	})
+	if err != nil {
+		return nil, func() {}, err
+	}
+
+	s = parseScene(cs)
+	return s, func() { s.Close() }, nil
+}
+
+//fsPath converts a path requested by assimp into an fs.FS path.
+//Assimp builds paths of sibling files by joining them to the directory of the imported file, using '\' on windows.
+func fsPath(p string) (string, bool) {
+
+	p = strings.ReplaceAll(p, "\\", "/")
+	p = strings.TrimLeft(path.Clean(p), "/")
+	if p == "" {
+		p = "."
+	}
+
+	return p, fs.ValidPath(p)
+}
+
+//export asigFSOpen
+func asigFSOpen(fsHandle C.uintptr_t, cPath *C.char, cMode *C.char) C.uintptr_t {
+
+	//Only reading is supported
+	if strings.ContainsAny(C.GoString(cMode), "wa+") {
+		return 0
+	}
+
+	p, ok := fsPath(C.GoString(cPath))
+	if !ok {
+		return 0
+	}
+
+	fsys := cgo.Handle(fsHandle).Value().(fs.FS)
+	data, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return 0
+	}
+
+	return C.uintptr_t(cgo.NewHandle(&fsFile{r: bytes.NewReader(data)}))
+}
+
+//export asigFSClose
+func asigFSClose(fileHandle C.uintptr_t) {
+	cgo.Handle(fileHandle).Delete()
+}
+
+//export asigFSRead
+func asigFSRead(fileHandle C.uintptr_t, buf *C.char, size, count C.size_t) C.size_t {
+
+	if size == 0 || count == 0 {
+		return 0
+	}
+
+	f := cgo.Handle(fileHandle).Value().(*fsFile)
+	out := unsafe.Slice((*byte)(unsafe.Pointer(buf)), size*count)
+
+	n, err := io.ReadFull(f.r, out)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return 0
+	}
+
+	//Like fread, we return the number of complete elements read
+	return C.size_t(n) / size
+}
+
+//export asigFSTell
+func asigFSTell(fileHandle C.uintptr_t) C.size_t {
+	f := cgo.Handle(fileHandle).Value().(*fsFile)
+	return C.size_t(f.r.Size() - int64(f.r.Len()))
+}
+
+//export asigFSSize
+func asigFSSize(fileHandle C.uintptr_t) C.size_t {
+	f := cgo.Handle(fileHandle).Value().(*fsFile)
+	return C.size_t(f.r.Size())
+}
+
+//export asigFSSeek
+func asigFSSeek(fileHandle C.uintptr_t, offset C.size_t, origin C.int) C.int {
+
+	f := cgo.Handle(fileHandle).Value().(*fsFile)
+
+	//Offsets are unsigned in the C API, but negative offsets (e.g. with aiOrigin_END) are passed wrapped around, so converting to int64 restores them
+	if _, err := f.r.Seek(int64(offset), int(origin)); err != nil {
+		return aiReturnFailure
+	}
+
+	return aiReturnSuccess
+}
@@ -0,0 +1,100 @@
+package asig
+
+import (
+	"io/fs"
+	"os"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFSPath(t *testing.T) {
+
+	tests := []struct {
+		in     string
+		want   string
+		wantOk bool
+	}{
+		{in: "model.obj", want: "model.obj", wantOk: true},
+		{in: "./model.obj", want: "model.obj", wantOk: true},
+		{in: "/model.obj", want: "model.obj", wantOk: true},
+		{in: "models/model.obj", want: "models/model.obj", wantOk: true},
+		{in: "models\\model.mtl", want: "models/model.mtl", wantOk: true},
+		{in: "models/./textures/../model.mtl", want: "models/model.mtl", wantOk: true},
+		{in: "models//model.mtl", want: "models/model.mtl", wantOk: true},
+		{in: "", want: ".", wantOk: true},
+		{in: "/", want: ".", wantOk: true},
+		{in: "../model.obj", want: "../model.obj", wantOk: false},
+		{in: "models\\..\\..\\model.obj", want: "../model.obj", wantOk: false},
+	}
+
+	for _, tt := range tests {
+
+		got, ok := fsPath(tt.in)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("fsPath(%q) = (%q, %v), want (%q, %v)", tt.in, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+//Assimp opens sibling files by joining their names to the directory of the imported file,
+//so the converted paths must be readable from the fs.FS the model came from
+func TestFSPathOpensSiblings(t *testing.T) {
+
+	fsys := fstest.MapFS{
+		"models/cube.obj":          {Data: []byte("mtllib cube.mtl")},
+		"models/cube.mtl":          {Data: []byte("newmtl red")},
+		"models/textures/cube.png": {Data: []byte("png")},
+	}
+
+	tests := []struct {
+		assimpPath string
+		want       string
+	}{
+		{assimpPath: "models/cube.obj", want: "mtllib cube.mtl"},
+		{assimpPath: "models/cube.mtl", want: "newmtl red"},
+		{assimpPath: "models\\cube.mtl", want: "newmtl red"},
+		{assimpPath: "/models/textures/cube.png", want: "png"},
+		{assimpPath: "models\\textures\\..\\cube.mtl", want: "newmtl red"},
+	}
+
+	for _, tt := range tests {
+
+		p, ok := fsPath(tt.assimpPath)
+		if !ok {
+			t.Errorf("fsPath(%q) returned an invalid path %q", tt.assimpPath, p)
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			t.Errorf("reading %q (from %q) failed: %v", p, tt.assimpPath, err)
+			continue
+		}
+
+		if string(data) != tt.want {
+			t.Errorf("reading %q (from %q) = %q, want %q", p, tt.assimpPath, data, tt.want)
+		}
+	}
+}
+
+//The working directory is the package directory, where assimp can't find the model or its MTL,
+//so the import only succeeds if both are read through fsys
+func TestImportFSFixture(t *testing.T) {
+
+	requireLibrary(t)
+
+	s, release, err := ImportFS(os.DirFS("testdata"), "tri.obj", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	if len(s.Meshes) != 1 || s.Meshes[0].MaterialIndex >= uint(len(s.Materials)) {
+		t.Fatalf("got %d meshes and %d materials, want one mesh using one of the materials", len(s.Meshes), len(s.Materials))
+	}
+
+	mat := s.Materials[s.Meshes[0].MaterialIndex]
+	if name, err := GetMaterialString(mat, MatKeyName, TextureTypeNone, 0); err != nil || name != "Red" {
+		t.Errorf("material name = (%q, %v), want Red from the MTL", name, err)
+	}
+}
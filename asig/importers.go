@@ -0,0 +1,123 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include <stdlib.h>
+#include "wrap.c"
+*/
+import "C"
+import (
+	"strings"
+	"unsafe"
+)
+
+//ImporterDesc describes a file format assimp can import
+type ImporterDesc struct {
+	//Full name of the importer (e.g. "Blender 3D Importer (http://www.blender3d.org)")
+	Name string
+
+	//Original author, empty if unknown or the whole assimp team
+	Author string
+
+	//Current maintainer, empty if the author maintains it
+	Maintainer string
+
+	//Implementation comments, e.g. unimplemented features
+	Comments string
+
+	Flags ImporterFlags
+
+	/** Minimum and maximum format versions that can be loaded, in major.minor format.
+	 *
+	 * They are all 0 if the format has no version scheme or the importer doesn't care.
+	 * The maximum is also 0 if the importer expects to be forward-compatible with future versions.
+	 */
+	MinMajor uint
+	MinMinor uint
+	MaxMajor uint
+	MaxMinor uint
+
+	//File extensions this importer handles, in lower case without a leading dot (e.g. "obj").
+	//Multiple importers may handle the same extension
+	Extensions []string
+}
+
+//ImportFormats returns all the file formats supported by the assimp library in use
+func ImportFormats() ([]*ImporterDesc, error) {
+
+	if err := libraryErr(); err != nil {
+		return nil, err
+	}
+
+	count := int(C.aiGetImportFormatCount())
+	formats := make([]*ImporterDesc, 0, count)
+	for i := 0; i < count; i++ {
+
+		//Descriptions are owned by assimp and must not be released
+		cDesc := C.aiGetImportFormatDescription(C.size_t(i))
+		if cDesc == nil {
+			continue
+		}
+
+		formats = append(formats, &ImporterDesc{
+			Name:       C.GoString(cDesc.mName),
+			Author:     C.GoString(cDesc.mAuthor),
+			Maintainer: C.GoString(cDesc.mMaintainer),
+			Comments:   C.GoString(cDesc.mComments),
+			Flags:      ImporterFlags(cDesc.mFlags),
+			MinMajor:   uint(cDesc.mMinMajor),
+			MinMinor:   uint(cDesc.mMinMinor),
+			MaxMajor:   uint(cDesc.mMaxMajor),
+			MaxMinor:   uint(cDesc.mMaxMinor),
+			Extensions: strings.Fields(strings.ToLower(C.GoString(cDesc.mFileExtensions))),
+		})
+	}
+
+	return formats, nil
+}
+
+//ImportExtensions returns all the file extensions assimp can import, in lower case without a leading dot (e.g. "obj").
+//An extension being supported doesn't mean every file with that extension can be imported
+func ImportExtensions() ([]string, error) {
+
+	if err := libraryErr(); err != nil {
+		return nil, err
+	}
+
+	aiStr := C.struct_aiString{}
+	C.aiGetExtensionList(&aiStr)
+
+	//The list is formatted like "*.3ds;*.obj;*.dae"
+	exts := []string{}
+	for _, ext := range strings.Split(parseAiString(aiStr), ";") {
+
+		ext = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), "*."))
+		if ext == "" {
+			continue
+		}
+
+		exts = append(exts, ext)
+	}
+
+	return exts, nil
+}
+
+//IsExtensionSupported reports whether assimp can import files with the given extension.
+//The extension may have a leading dot or not, so filepath.Ext(fileName) can be passed directly
+func IsExtensionSupported(ext string) (bool, error) {
+
+	if err := libraryErr(); err != nil {
+		return false, err
+	}
+
+	ext = strings.TrimPrefix(strings.TrimSpace(ext), ".")
+	if ext == "" {
+		return false, nil
+	}
+
+	cExt := C.CString("." + ext)
+	defer C.free(unsafe.Pointer(cExt))
+
+	return C.aiIsExtensionSupported(cExt) == C.AI_TRUE, nil
+}
@@ -0,0 +1,18 @@
+package asig
+
+import "errors"
+
+//LibraryNotLoadedError is returned by asig calls made before assimp is available,
+//which can only happen when building with the asig_dynamic tag and LoadLibrary wasn't called (or failed).
+type LibraryNotLoadedError struct{}
+
+func (e *LibraryNotLoadedError) Error() string {
+	return "asig error: assimp library is not loaded, call asig.LoadLibrary first"
+}
+
+//ErrLibraryNotLoaded can be used with errors.Is to check for a LibraryNotLoadedError
+var ErrLibraryNotLoaded error = &LibraryNotLoadedError{}
+
+//ErrLibraryVersionMismatch is wrapped by the error returned when the assimp library has a different major/minor version than
+//the asig headers (see HeaderVersionMajor). With the asig_system tag (the default on Linux) asig calls return it, and with asig_dynamic LoadLibrary does
+var ErrLibraryVersionMismatch = errors.New("asig error: assimp library version does not match the asig headers")
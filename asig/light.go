@@ -0,0 +1,72 @@
+package asig
+
+import "github.com/bloeys/gglm/gglm"
+
+/** Helper structure to describe a light source.
+ *
+ *  Assimp supports multiple sorts of light sources, including
+ *  directional, point and spot lights. All of them are defined with just
+ *  a single structure and distinguished by their parameters.
+ *  Note - some file formats (such as 3DS, ASE) export a "target point" -
+ *  the point a spot light is looking at (it can even be animated). Assimp
+ *  writes the target point as a subnode of a spotlights's main node,
+ *  called "<spotName>.Target". However, this is just additional information
+ *  then, the transformation tracks of the main node make the
+ *  spot light already point in the right direction.
+ */
+type Light struct {
+
+	/** The name of the light source.
+	 *
+	 *  There must be a node in the scenegraph with the same name.
+	 *  This node specifies the position of the light in the scene
+	 *  hierarchy and can be animated. Use Scene.LightNode to find it.
+	 */
+	Name string
+
+	//The type of the light source. LightTypeUndefined is not a valid value for this member
+	Type LightType
+
+	//Position of the light source in space. Relative to the transformation of the node corresponding to the light.
+	//The position is undefined for directional lights.
+	Position gglm.Vec3
+
+	//Direction of the light source in space. Relative to the transformation of the node corresponding to the light.
+	//The direction is undefined for point lights. The vector may be normalized, but it needn't.
+	Direction gglm.Vec3
+
+	//Up direction of the light source in space. Relative to the transformation of the node corresponding to the light.
+	//The direction is undefined for point lights. The vector may be normalized, but it needn't.
+	Up gglm.Vec3
+
+	/** Attenuation factors of the light. Naturally undefined for directional lights.
+	 *
+	 *  The intensity of the light source at a given distance 'd' from
+	 *  the light's position is:
+	 *  Atten = 1/( AttenuationConstant + AttenuationLinear * d + AttenuationQuadratic * d*d)
+	 */
+	AttenuationConstant  float32
+	AttenuationLinear    float32
+	AttenuationQuadratic float32
+
+	//Diffuse color of the light source (RGB). Multiplied with the diffuse material color to get the diffuse shading term
+	ColorDiffuse gglm.Vec3
+
+	//Specular color of the light source (RGB). Multiplied with the specular material color to get the specular shading term
+	ColorSpecular gglm.Vec3
+
+	//Ambient color of the light source (RGB). Multiplied with the ambient material color to get the ambient shading term.
+	//Most renderers will ignore this value, as it is just a remnant of the fixed-function pipeline.
+	ColorAmbient gglm.Vec3
+
+	//Inner angle of a spot light's light cone, in radians. The spot light has maximum influence on objects inside this angle.
+	//It is 2PI for point lights and undefined for directional lights.
+	AngleInnerCone float32
+
+	//Outer angle of a spot light's light cone, in radians. The spot light does not affect objects outside this angle.
+	//It is 2PI for point lights and undefined for directional lights. The outer angle must be greater than or equal to the inner angle.
+	AngleOuterCone float32
+
+	//Size of area light source
+	Size gglm.Vec2
+}
@@ -0,0 +1,22 @@
+//go:build !asig_system && !asig_dynamic && !linux
+// +build !asig_system,!asig_dynamic,!linux
+
+package asig
+
+/*
+#cgo LDFLAGS: -L libs
+#cgo windows,amd64 LDFLAGS: -l assimp_windows_amd64
+#cgo darwin,amd64 LDFLAGS: -l assimp_darwin_amd64
+#cgo darwin,arm64 LDFLAGS: -l assimp_darwin_arm64
+*/
+import "C"
+
+//IsLibraryLoaded reports whether assimp is available. This is always true unless built with the asig_dynamic tag
+func IsLibraryLoaded() bool {
+	return true
+}
+
+//libraryErr returns the error asig calls should return because assimp isn't usable, or nil if it is
+func libraryErr() error {
+	return nil
+}
@@ -0,0 +1,80 @@
+//go:build asig_dynamic
+// +build asig_dynamic
+
+package asig
+
+/*
+#cgo CFLAGS: -I .
+#cgo linux LDFLAGS: -ldl
+
+#include <stdlib.h>
+
+void* asig_dl_open(const char* path, const char** outErr);
+void asig_dl_close(void);
+*/
+import "C"
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+var (
+	libMutex      sync.RWMutex
+	libLoadedPath string
+)
+
+//LoadLibrary opens the assimp shared library at path (e.g. /usr/lib/libassimp.so.5) and makes it the library used by all asig calls.
+//Until it succeeds, asig calls return ErrLibraryNotLoaded.
+//
+//Only one library can be loaded per process, so calling LoadLibrary again with a different path returns an error.
+//The library's version must match HeaderVersionMajor/HeaderVersionMinor, otherwise it is closed again and an error is returned.
+func LoadLibrary(path string) error {
+
+	libMutex.Lock()
+	defer libMutex.Unlock()
+
+	if libLoadedPath != "" {
+
+		if libLoadedPath == path {
+			return nil
+		}
+
+		return errors.New("asig error: can not load assimp library '" + path + "' because '" + libLoadedPath + "' is already loaded")
+	}
+
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var cErr *C.char
+	if C.asig_dl_open(cPath, &cErr) == nil {
+		return errors.New("asig error: failed to load assimp library '" + path + "': " + C.GoString(cErr))
+	}
+
+	if err := checkLibVersion(); err != nil {
+		C.asig_dl_close()
+		return err
+	}
+
+	libLoadedPath = path
+	return nil
+}
+
+//IsLibraryLoaded reports whether LoadLibrary has successfully loaded assimp
+func IsLibraryLoaded() bool {
+
+	libMutex.RLock()
+	defer libMutex.RUnlock()
+
+	return libLoadedPath != ""
+}
+
+//libraryErr returns the error asig calls should return because assimp isn't usable, or nil if it is
+func libraryErr() error {
+
+	if !IsLibraryLoaded() {
+		return ErrLibraryNotLoaded
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build asig_dynamic
+// +build asig_dynamic
+
+package asig
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+//requireLibrary loads the assimp library at $ASIG_TEST_LIBRARY, or skips the test if it isn't set
+func requireLibrary(t *testing.T) {
+
+	path := os.Getenv("ASIG_TEST_LIBRARY")
+	if path == "" {
+		t.Skip("set ASIG_TEST_LIBRARY to the path of an assimp library to run tests that import models")
+	}
+
+	if err := LoadLibrary(path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+//Before LoadLibrary every call needing assimp must fail with ErrLibraryNotLoaded instead of calling the C stubs
+func TestCallsBeforeLoadLibrary(t *testing.T) {
+
+	if IsLibraryLoaded() {
+		t.Skip("the library was loaded by a test that imports models (see requireLibrary)")
+	}
+
+	_, _, err := ImportFile("model.obj", 0)
+	if !errors.Is(err, ErrLibraryNotLoaded) {
+		t.Errorf("ImportFile() error = %v, want %v", err, ErrLibraryNotLoaded)
+	}
+
+	_, _, err = ImportFromMemory([]byte("o cube"), "obj", 0)
+	if !errors.Is(err, ErrLibraryNotLoaded) {
+		t.Errorf("ImportFromMemory() error = %v, want %v", err, ErrLibraryNotLoaded)
+	}
+
+	_, err = ExportFormats()
+	if !errors.Is(err, ErrLibraryNotLoaded) {
+		t.Errorf("ExportFormats() error = %v, want %v", err, ErrLibraryNotLoaded)
+	}
+
+	err = (&Scene{RootNode: &Node{}}).Marshal()
+	if !errors.Is(err, ErrLibraryNotLoaded) {
+		t.Errorf("Marshal() error = %v, want %v", err, ErrLibraryNotLoaded)
+	}
+}
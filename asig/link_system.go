@@ -0,0 +1,32 @@
+//go:build (asig_system || linux) && !asig_dynamic
+// +build asig_system linux
+// +build !asig_dynamic
+
+//On Linux there are no bundled libs, so the system assimp is always used unless building with asig_dynamic
+
+package asig
+
+/*
+#cgo pkg-config: assimp
+*/
+import "C"
+
+//When linking against a system assimp the headers in asig/assimp are still the ones compiled into the binding,
+//so we must make sure the library we got has the same struct layouts before anything touches its data.
+//A mismatch doesn't stop the program, instead asig calls return the error (see libraryErr)
+var libVersionErr error
+
+func init() {
+	libVersionErr = checkLibVersion()
+}
+
+//IsLibraryLoaded reports whether assimp is available, which is false if the system assimp has a different version than the asig headers.
+//asig calls then return an error wrapping ErrLibraryVersionMismatch
+func IsLibraryLoaded() bool {
+	return libVersionErr == nil
+}
+
+//libraryErr returns the error asig calls should return because assimp isn't usable, or nil if it is
+func libraryErr() error {
+	return libVersionErr
+}
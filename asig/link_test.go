@@ -0,0 +1,14 @@
+//go:build !asig_dynamic
+// +build !asig_dynamic
+
+package asig
+
+import "testing"
+
+//requireLibrary fails the test if the linked assimp can't be used
+func requireLibrary(t *testing.T) {
+
+	if err := libraryErr(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,245 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include <stdint.h>
+#include <stdlib.h>
+#include "wrap.c"
+
+struct aiLogStream* asig_logstream(void);
+*/
+import "C"
+import (
+	"strings"
+	"sync"
+)
+
+//LogMessage is a message logged by assimp
+type LogMessage struct {
+	Severity LogSeverity
+	Message  string
+}
+
+func (lm LogMessage) String() string {
+	return lm.Severity.String() + ": " + lm.Message
+}
+
+/** asig attaches exactly one log stream to assimp, and sends its messages to all the Go subscribers (logStream).
+ *
+ * Assimp compares streams by their callback and user fields, so separate C streams with the same callback would replace each other.
+ * Instead the C stream is attached when the first subscriber is added, and detached when the last one is removed. Detaching the last stream
+ * makes assimp go back to its null logger, so calls that run assimp code can run in parallel again (see lockLogging).
+ * Attaching and detaching are protected by logLock, as assimp's log streams can't be changed while assimp might be logging.
+ */
+var (
+	//logLock protects assimp's (global) logger. Changing log streams needs the write lock, and calls that run assimp code
+	//which might log take it with lockLogging. Calls that also take importLock or lock a scene (cSceneRef.lock) must take logLock first,
+	//or they can deadlock with each other.
+	logLock            sync.RWMutex
+	cLogStreamAttached bool
+
+	logSubscribersLock sync.RWMutex
+	logSubscribers     = map[*logStream]struct{}{}
+)
+
+//logStream is a subscription to the messages assimp logs
+type logStream struct {
+	onMsg func(LogMessage)
+}
+
+//attachLogStream starts sending every message assimp logs to onMsg until detach is called.
+//onMsg might be called from any thread that uses assimp. The write lock of logLock must be held
+func attachLogStream(onMsg func(LogMessage)) *logStream {
+
+	if !cLogStreamAttached {
+		C.aiAttachLogStream(C.asig_logstream())
+		cLogStreamAttached = true
+	}
+
+	ls := &logStream{onMsg: onMsg}
+
+	logSubscribersLock.Lock()
+	logSubscribers[ls] = struct{}{}
+	logSubscribersLock.Unlock()
+
+	return ls
+}
+
+//detach stops sending messages to the subscriber, and detaches the C stream if this was the last one.
+//The write lock of logLock must be held
+func (ls *logStream) detach() {
+
+	logSubscribersLock.Lock()
+	delete(logSubscribers, ls)
+	subscriberCount := len(logSubscribers)
+	logSubscribersLock.Unlock()
+
+	if subscriberCount == 0 && cLogStreamAttached {
+		C.aiDetachLogStream(C.asig_logstream())
+		cLogStreamAttached = false
+	}
+}
+
+//lockLogging locks logLock for a call that runs assimp code which might log, and returns the matching unlock function.
+//While no log stream is attached assimp uses its null logger and such calls can run in parallel, so the read lock is enough.
+//Otherwise the write lock is taken, as assimp's default logger isn't safe to use from several threads at once
+func lockLogging() (unlock func()) {
+
+	logLock.RLock()
+	if !cLogStreamAttached {
+		return logLock.RUnlock
+	}
+	logLock.RUnlock()
+
+	logLock.Lock()
+	return logLock.Unlock
+}
+
+//export asigLogMessage
+func asigLogMessage(cMsg *C.char) {
+	dispatchLogMessage(C.GoString(cMsg))
+}
+
+//dispatchLogMessage sends a message logged by assimp to all subscribers
+func dispatchLogMessage(rawMsg string) {
+
+	lm := parseLogMessage(rawMsg)
+
+	//Subscribers are called without the lock, so they can be slow or detach themselves
+	logSubscribersLock.RLock()
+	subscribers := make([]*logStream, 0, len(logSubscribers))
+	for ls := range logSubscribers {
+		subscribers = append(subscribers, ls)
+	}
+	logSubscribersLock.RUnlock()
+
+	for _, ls := range subscribers {
+		ls.onMsg(lm)
+	}
+}
+
+var logSeverityPrefixes = []struct {
+	prefix   string
+	severity LogSeverity
+}{
+	{prefix: "Debug,", severity: LogSeverityDebug},
+	{prefix: "Info,", severity: LogSeverityInfo},
+	{prefix: "Warn,", severity: LogSeverityWarn},
+	{prefix: "Error,", severity: LogSeverityError},
+}
+
+//parseLogMessage parses messages formatted by assimp's default logger, like 'Warn,  T0: message\n'
+func parseLogMessage(rawMsg string) LogMessage {
+
+	lm := LogMessage{
+		Severity: LogSeverityInfo,
+		Message:  strings.TrimRight(rawMsg, "\r\n"),
+	}
+
+	for _, p := range logSeverityPrefixes {
+
+		if !strings.HasPrefix(lm.Message, p.prefix) {
+			continue
+		}
+
+		lm.Severity = p.severity
+		lm.Message = strings.TrimLeft(lm.Message[len(p.prefix):], " ")
+
+		//Remove the thread id
+		if sepIndex := strings.Index(lm.Message, ": "); sepIndex > 1 && lm.Message[0] == 'T' && isDigits(lm.Message[1:sepIndex]) {
+			lm.Message = lm.Message[sepIndex+2:]
+		}
+
+		break
+	}
+
+	return lm
+}
+
+func isDigits(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+//logFuncStream is the subscriber set by SetLogFunc. It is protected by logLock
+var logFuncStream *logStream
+
+//SetLogFunc sends every message assimp logs to logFunc, replacing the previous log function (including one set with SetSlogLogger).
+//Passing nil stops logging. logFunc might be called from any goroutine that uses asig. SetLogFunc waits for asig calls in progress to finish.
+//While a log function is set, calls that run assimp code (imports, exports and post processing) don't run in parallel, because assimp's logger isn't thread-safe.
+//
+//Debug messages are only logged after EnableVerboseLogging(true)
+func SetLogFunc(logFunc func(severity LogSeverity, msg string)) error {
+
+	if err := libraryErr(); err != nil {
+		return err
+	}
+
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	if logFuncStream != nil {
+		logFuncStream.detach()
+		logFuncStream = nil
+	}
+
+	if logFunc == nil {
+		return nil
+	}
+
+	logFuncStream = attachLogStream(func(lm LogMessage) {
+		logFunc(lm.Severity, lm.Message)
+	})
+
+	return nil
+}
+
+//EnableVerboseLogging enables debug messages and detailed import statistics.
+//This can have a severe impact on import performance and memory consumption.
+func EnableVerboseLogging(enable bool) error {
+
+	if err := libraryErr(); err != nil {
+		return err
+	}
+
+	var aiEnable C.aiBool = C.AI_FALSE
+	if enable {
+		aiEnable = C.AI_TRUE
+	}
+
+	//This changes the severity of the logger, so it must not happen while assimp logs
+	logLock.Lock()
+	C.aiEnableVerboseLogging(aiEnable)
+	logLock.Unlock()
+
+	return nil
+}
+
+//DetachAllLogStreams stops all logging, including the log function set with SetLogFunc or SetSlogLogger, and frees the resources of assimp's logger.
+//It waits for asig calls in progress to finish first.
+func DetachAllLogStreams() error {
+
+	if err := libraryErr(); err != nil {
+		return err
+	}
+
+	//Imports capturing warnings need the C stream, so it must not be detached from under them
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	if logFuncStream != nil {
+		logFuncStream.detach()
+		logFuncStream = nil
+	}
+
+	C.aiDetachAllLogStreams()
+	cLogStreamAttached = false
+	return nil
+}
@@ -0,0 +1,39 @@
+//go:build go1.21
+// +build go1.21
+
+package asig
+
+import (
+	"context"
+	"log/slog"
+)
+
+//SetSlogLogger sends every message assimp logs to logger, replacing the previous log function (including one set with SetLogFunc).
+//Passing nil stops logging. Severities are mapped using LogSeverity.SlogLevel.
+//
+//Debug messages are only logged after EnableVerboseLogging(true)
+func SetSlogLogger(logger *slog.Logger) error {
+
+	if logger == nil {
+		return SetLogFunc(nil)
+	}
+
+	return SetLogFunc(func(severity LogSeverity, msg string) {
+		logger.Log(context.Background(), severity.SlogLevel(), msg)
+	})
+}
+
+//SlogLevel returns the slog level with the same name as the severity
+func (ls LogSeverity) SlogLevel() slog.Level {
+
+	switch ls {
+	case LogSeverityDebug:
+		return slog.LevelDebug
+	case LogSeverityWarn:
+		return slog.LevelWarn
+	case LogSeverityError:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,83 @@
+package asig
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLogMessage(t *testing.T) {
+
+	tests := []struct {
+		raw  string
+		want LogMessage
+	}{
+		{raw: "Warn,  T0: missing texture a.png\n", want: LogMessage{Severity: LogSeverityWarn, Message: "missing texture a.png"}},
+		{raw: "Error, T12: bad thing\r\n", want: LogMessage{Severity: LogSeverityError, Message: "bad thing"}},
+		{raw: "Info,  T0: Load mem", want: LogMessage{Severity: LogSeverityInfo, Message: "Load mem"}},
+		{raw: "Debug, T3: dbg\n", want: LogMessage{Severity: LogSeverityDebug, Message: "dbg"}},
+		{raw: "Warn,  no thread id\n", want: LogMessage{Severity: LogSeverityWarn, Message: "no thread id"}},
+		{raw: "Warn,  Tx: not a thread id\n", want: LogMessage{Severity: LogSeverityWarn, Message: "Tx: not a thread id"}},
+		{raw: "Warn,  T: empty thread id\n", want: LogMessage{Severity: LogSeverityWarn, Message: "T: empty thread id"}},
+		{raw: "Error, T0: Validation failed: a: b\n", want: LogMessage{Severity: LogSeverityError, Message: "Validation failed: a: b"}},
+		{raw: "Skipping one or more lines with the same contents\n", want: LogMessage{Severity: LogSeverityInfo, Message: "Skipping one or more lines with the same contents"}},
+		{raw: "warn, lower case prefixes aren't assimp's\n", want: LogMessage{Severity: LogSeverityInfo, Message: "warn, lower case prefixes aren't assimp's"}},
+		{raw: "", want: LogMessage{Severity: LogSeverityInfo, Message: ""}},
+	}
+
+	for _, tt := range tests {
+		if got := parseLogMessage(tt.raw); got != tt.want {
+			t.Errorf("parseLogMessage(%q) = %+v, want %+v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+//Every subscriber must get every message, and detaching one subscriber must not affect the others
+func TestDispatchLogMessage(t *testing.T) {
+
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	global := []LogMessage{}
+	globalStream := attachLogStream(func(lm LogMessage) { global = append(global, lm) })
+	defer globalStream.detach()
+
+	collected := []LogMessage{}
+	collector := attachLogStream(func(lm LogMessage) { collected = append(collected, lm) })
+
+	dispatchLogMessage("Warn,  T0: first\n")
+	collector.detach()
+	dispatchLogMessage("Error, T0: second\n")
+
+	wantGlobal := []LogMessage{{Severity: LogSeverityWarn, Message: "first"}, {Severity: LogSeverityError, Message: "second"}}
+	if !reflect.DeepEqual(global, wantGlobal) {
+		t.Errorf("subscriber got %v, want %v", global, wantGlobal)
+	}
+
+	wantCollected := []LogMessage{{Severity: LogSeverityWarn, Message: "first"}}
+	if !reflect.DeepEqual(collected, wantCollected) {
+		t.Errorf("detached subscriber got %v, want %v", collected, wantCollected)
+	}
+}
+
+//The C stream must only stay attached while there are subscribers, so assimp can go back to its null logger
+func TestLogStreamDetachedWithLastSubscriber(t *testing.T) {
+
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	first := attachLogStream(func(lm LogMessage) {})
+	second := attachLogStream(func(lm LogMessage) {})
+	if !cLogStreamAttached {
+		t.Fatal("C log stream not attached after adding subscribers")
+	}
+
+	first.detach()
+	if !cLogStreamAttached {
+		t.Fatal("C log stream detached while a subscriber is left")
+	}
+
+	second.detach()
+	if cLogStreamAttached {
+		t.Fatal("C log stream still attached after removing the last subscriber")
+	}
+}
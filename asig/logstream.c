@@ -0,0 +1,18 @@
+// C side of the log stream in log.go. asig attaches a single stream to assimp, whose callback
+// forwards every message to an exported Go function that fans it out to the Go subscribers.
+
+#include <stdint.h>
+#include <stdlib.h>
+#include "wrap.c"
+#include "_cgo_export.h"
+
+static void asig_log_callback(const char* msg, char* user) {
+    asigLogMessage((char*)msg);
+}
+
+// assimp identifies streams by their callback and user fields, so every attach must use this same stream
+static struct aiLogStream asig_log_stream = {asig_log_callback, NULL};
+
+struct aiLogStream* asig_logstream(void) {
+    return &asig_log_stream;
+}
@@ -0,0 +1,119 @@
+// Allocation helpers for marshal.go and Scene.Clone. Scenes built by asig are freed by assimp with aiFreeScene, which uses the C++ delete
+// and delete[] of each type, so everything it frees is allocated here with new of the same type.
+//
+// The constructors of aiScene, aiNode and aiMaterial live in the assimp library. With the asig_dynamic tag they are
+// stubs in dynamic.c that forward to the loaded library, like the functions of the C API.
+
+#include <assimp/scene.h>
+
+// asig_new_array allocates a zeroed array of count objects, or returns nullptr if count is zero
+template <typename T>
+static T* asig_new_array(unsigned int count) {
+
+    if (count == 0) {
+        return nullptr;
+    }
+
+    return new T[count]();
+}
+
+extern "C" {
+
+aiScene* asig_cpp_new_scene(void) {
+    return new aiScene();
+}
+
+aiNode* asig_cpp_new_node(void) {
+    return new aiNode();
+}
+
+aiMesh* asig_cpp_new_mesh(void) {
+    return new aiMesh();
+}
+
+aiBone* asig_cpp_new_bone(void) {
+    return new aiBone();
+}
+
+// asig_cpp_new_material creates a material with room for numProperties properties.
+// The constructor already allocates a few, so the array is only replaced if that isn't enough
+aiMaterial* asig_cpp_new_material(unsigned int numProperties) {
+
+    aiMaterial* mat = new aiMaterial();
+    if (numProperties > mat->mNumAllocated) {
+        delete[] mat->mProperties;
+        mat->mProperties = new aiMaterialProperty*[numProperties]();
+        mat->mNumAllocated = numProperties;
+    }
+
+    return mat;
+}
+
+aiMaterialProperty* asig_cpp_new_material_property(void) {
+    return new aiMaterialProperty();
+}
+
+aiTexture* asig_cpp_new_texture(void) {
+    return new aiTexture();
+}
+
+// Arrays of pointers to the objects above
+
+aiNode** asig_cpp_new_node_ptrs(unsigned int count) {
+    return asig_new_array<aiNode*>(count);
+}
+
+aiMesh** asig_cpp_new_mesh_ptrs(unsigned int count) {
+    return asig_new_array<aiMesh*>(count);
+}
+
+aiBone** asig_cpp_new_bone_ptrs(unsigned int count) {
+    return asig_new_array<aiBone*>(count);
+}
+
+aiMaterial** asig_cpp_new_material_ptrs(unsigned int count) {
+    return asig_new_array<aiMaterial*>(count);
+}
+
+aiTexture** asig_cpp_new_texture_ptrs(unsigned int count) {
+    return asig_new_array<aiTexture*>(count);
+}
+
+// Arrays of values
+
+aiFace* asig_cpp_new_faces(unsigned int count) {
+    return asig_new_array<aiFace>(count);
+}
+
+unsigned int* asig_cpp_new_uints(unsigned int count) {
+    return asig_new_array<unsigned int>(count);
+}
+
+aiVector3D* asig_cpp_new_vec3s(unsigned int count) {
+    return asig_new_array<aiVector3D>(count);
+}
+
+aiColor4D* asig_cpp_new_colors(unsigned int count) {
+    return asig_new_array<aiColor4D>(count);
+}
+
+aiVertexWeight* asig_cpp_new_weights(unsigned int count) {
+    return asig_new_array<aiVertexWeight>(count);
+}
+
+// asig_cpp_new_bytes allocates the data of a material property
+char* asig_cpp_new_bytes(unsigned int count) {
+    return asig_new_array<char>(count);
+}
+
+// asig_cpp_new_texels allocates the data of a texture. Compressed textures store their file in it, so the size is in bytes
+aiTexel* asig_cpp_new_texels(unsigned int byteCount) {
+    return asig_new_array<aiTexel>((byteCount + sizeof(aiTexel) - 1) / sizeof(aiTexel));
+}
+
+// asig_cpp_copy_metadata returns a deep copy of src, which the scene it is set on frees
+aiMetadata* asig_cpp_copy_metadata(const aiMetadata* src) {
+    return new aiMetadata(*src);
+}
+
+}
@@ -0,0 +1,480 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+#cgo CXXFLAGS: -I .
+
+#include <stdlib.h>
+#include "wrap.c"
+
+struct aiScene* asig_cpp_new_scene(void);
+struct aiNode* asig_cpp_new_node(void);
+struct aiMesh* asig_cpp_new_mesh(void);
+struct aiBone* asig_cpp_new_bone(void);
+struct aiMaterial* asig_cpp_new_material(unsigned int numProperties);
+struct aiMaterialProperty* asig_cpp_new_material_property(void);
+struct aiTexture* asig_cpp_new_texture(void);
+
+struct aiNode** asig_cpp_new_node_ptrs(unsigned int count);
+struct aiMesh** asig_cpp_new_mesh_ptrs(unsigned int count);
+struct aiBone** asig_cpp_new_bone_ptrs(unsigned int count);
+struct aiMaterial** asig_cpp_new_material_ptrs(unsigned int count);
+struct aiTexture** asig_cpp_new_texture_ptrs(unsigned int count);
+
+struct aiFace* asig_cpp_new_faces(unsigned int count);
+unsigned int* asig_cpp_new_uints(unsigned int count);
+struct aiVector3D* asig_cpp_new_vec3s(unsigned int count);
+struct aiColor4D* asig_cpp_new_colors(unsigned int count);
+struct aiVertexWeight* asig_cpp_new_weights(unsigned int count);
+char* asig_cpp_new_bytes(unsigned int count);
+struct aiTexel* asig_cpp_new_texels(unsigned int byteCount);
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/bloeys/gglm/gglm"
+)
+
+/** Marshal builds a new C scene from the Go data of the scene, so that changes made in Go are used by Export
+ * and the material getters. It also works on scenes created in Go (e.g. &asig.Scene{RootNode: ...}),
+ * which must be closed with Close like imported scenes.
+ *
+ * Assimp can only post process scenes it imported, so ApplyPostProcessing returns an error on marshalled scenes.
+ * Post processing flags can still be applied while exporting (see Export), or to a copy made with Scene.Clone.
+ *
+ * The following are marshalled: Flags, the node hierarchy (without node metadata), meshes with all their vertex channels, faces and bones,
+ * materials with their properties and embedded textures. Animations, anim meshes, lights, cameras and scene metadata are not.
+ *
+ * The previous C scene (if any) is released, and the materials and textures in the scene are updated to use the new one.
+ * Marshal must not be called while the scene is used by other goroutines.
+ */
+func (s *Scene) Marshal() error {
+
+	if err := libraryErr(); err != nil {
+		return err
+	}
+
+	if err := s.validateForMarshal(); err != nil {
+		return err
+	}
+
+	cs := C.asig_cpp_new_scene()
+	cs.mFlags = C.uint(s.Flags)
+	cs.mRootNode = marshalNode(s.RootNode, nil)
+
+	cs.mNumMeshes = C.uint(len(s.Meshes))
+	cs.mMeshes = C.asig_cpp_new_mesh_ptrs(C.uint(len(s.Meshes)))
+	cMeshes := unsafe.Slice(cs.mMeshes, len(s.Meshes))
+	for i, m := range s.Meshes {
+		cMeshes[i] = marshalMesh(m)
+	}
+
+	cs.mNumMaterials = C.uint(len(s.Materials))
+	cs.mMaterials = C.asig_cpp_new_material_ptrs(C.uint(len(s.Materials)))
+	cMats := unsafe.Slice(cs.mMaterials, len(s.Materials))
+	for i, m := range s.Materials {
+		cMats[i] = marshalMaterial(m)
+	}
+
+	cs.mNumTextures = C.uint(len(s.Textures))
+	cs.mTextures = C.asig_cpp_new_texture_ptrs(C.uint(len(s.Textures)))
+	cTextures := unsafe.Slice(cs.mTextures, len(s.Textures))
+	for i, t := range s.Textures {
+		cTextures[i] = marshalTexture(t)
+	}
+
+	newRef := &cSceneRef{cs: cs, marshalled: true}
+	for i, m := range s.Materials {
+		m.cScene = newRef
+		m.cMat = cMats[i]
+	}
+
+	for i, t := range s.Textures {
+		t.cTex = cTextures[i]
+	}
+
+	oldRef := s.cScene
+	s.cScene = newRef
+	if oldRef != nil {
+
+		if oldRef.releaseOnGC {
+			newRef.setReleaseOnGC()
+		}
+
+		oldRef.release()
+	}
+
+	return nil
+}
+
+//validateForMarshal checks everything that would make an invalid C scene, so Marshal can fail before allocating anything
+func (s *Scene) validateForMarshal() error {
+
+	if s.RootNode == nil {
+		return errors.New("asig error: can not marshal a scene without a root node")
+	}
+
+	if err := validateNodeForMarshal(s.RootNode, uint(len(s.Meshes))); err != nil {
+		return err
+	}
+
+	for i, m := range s.Meshes {
+
+		if m == nil {
+			return fmt.Errorf("asig error: can not marshal nil mesh %d", i)
+		}
+
+		vertCount := len(m.Vertices)
+		checkLen := func(channel string, l int) error {
+			if l != 0 && l != vertCount {
+				return fmt.Errorf("asig error: can not marshal mesh %d ('%s') because it has %d vertices but %d %s", i, m.Name, vertCount, l, channel)
+			}
+			return nil
+		}
+
+		if err := checkLen("normals", len(m.Normals)); err != nil {
+			return err
+		}
+
+		if err := checkLen("tangents", len(m.Tangents)); err != nil {
+			return err
+		}
+
+		if err := checkLen("bitangents", len(m.BitTangents)); err != nil {
+			return err
+		}
+
+		for j := 0; j < MaxColorSets; j++ {
+			if err := checkLen(fmt.Sprintf("colors in set %d", j), len(m.ColorSets[j])); err != nil {
+				return err
+			}
+		}
+
+		for j := 0; j < MaxTexCoords; j++ {
+			if err := checkLen(fmt.Sprintf("tex coords in channel %d", j), len(m.TexCoords[j])); err != nil {
+				return err
+			}
+		}
+
+		for j, f := range m.Faces {
+			for _, index := range f.Indices {
+				if index >= uint(vertCount) {
+					return fmt.Errorf("asig error: can not marshal mesh %d ('%s') because face %d has index %d but there are only %d vertices", i, m.Name, j, index, vertCount)
+				}
+			}
+		}
+
+		for _, b := range m.Bones {
+			for _, w := range b.Weights {
+				if w.VertIndex >= uint(vertCount) {
+					return fmt.Errorf("asig error: can not marshal mesh %d ('%s') because bone '%s' has a weight for vertex %d but there are only %d vertices", i, m.Name, b.Name, w.VertIndex, vertCount)
+				}
+			}
+		}
+
+		//Assimp requires at least one material for meshes to reference, even if it is an empty one
+		if len(s.Materials) == 0 {
+			return fmt.Errorf("asig error: can not marshal mesh %d ('%s') because the scene has no materials", i, m.Name)
+		}
+
+		if m.MaterialIndex >= uint(len(s.Materials)) {
+			return fmt.Errorf("asig error: can not marshal mesh %d ('%s') because its material index is %d but there are only %d materials", i, m.Name, m.MaterialIndex, len(s.Materials))
+		}
+	}
+
+	for i, m := range s.Materials {
+		if m == nil {
+			return fmt.Errorf("asig error: can not marshal nil material %d", i)
+		}
+	}
+
+	for i, t := range s.Textures {
+
+		if t == nil {
+			return fmt.Errorf("asig error: can not marshal nil texture %d", i)
+		}
+
+		dataLen := t.Width
+		if t.Height != 0 {
+			dataLen = t.Width * t.Height * 4
+		}
+
+		if uint(len(t.Data)) != dataLen {
+			return fmt.Errorf("asig error: can not marshal texture %d ('%s') because its size needs %d bytes of data but it has %d", i, t.Filename, dataLen, len(t.Data))
+		}
+	}
+
+	return nil
+}
+
+func validateNodeForMarshal(n *Node, meshCount uint) error {
+
+	for _, meshIndex := range n.MeshIndicies {
+		if meshIndex >= meshCount {
+			return fmt.Errorf("asig error: can not marshal node '%s' because it uses mesh %d but there are only %d meshes", n.Name, meshIndex, meshCount)
+		}
+	}
+
+	for _, c := range n.Children {
+
+		if c == nil {
+			return fmt.Errorf("asig error: can not marshal node '%s' because it has a nil child", n.Name)
+		}
+
+		if err := validateNodeForMarshal(c, meshCount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// Marshalers
+//
+
+func marshalNode(n *Node, cParent *C.struct_aiNode) *C.struct_aiNode {
+
+	cn := C.asig_cpp_new_node()
+	cn.mName = toAiString(n.Name)
+	cn.mParent = cParent
+
+	if n.Transformation != nil {
+		cn.mTransformation = toAiMat4(n.Transformation)
+	} else {
+		identity := gglm.NewTrMatId().Mat4
+		cn.mTransformation = toAiMat4(&identity)
+	}
+
+	cn.mNumMeshes = C.uint(len(n.MeshIndicies))
+	cn.mMeshes = marshalUInts(n.MeshIndicies)
+
+	cn.mNumChildren = C.uint(len(n.Children))
+	cn.mChildren = C.asig_cpp_new_node_ptrs(C.uint(len(n.Children)))
+	cChildren := unsafe.Slice(cn.mChildren, len(n.Children))
+	for i, c := range n.Children {
+		cChildren[i] = marshalNode(c, cn)
+	}
+
+	return cn
+}
+
+func marshalMesh(m *Mesh) *C.struct_aiMesh {
+
+	cm := C.asig_cpp_new_mesh()
+	cm.mName = toAiString(m.Name)
+	cm.mMaterialIndex = C.uint(m.MaterialIndex)
+	cm.mMethod = C.uint(m.MorphMethod)
+
+	cm.mNumVertices = C.uint(len(m.Vertices))
+	cm.mVertices = marshalVec3s(m.Vertices)
+	cm.mNormals = marshalVec3s(m.Normals)
+	cm.mTangents = marshalVec3s(m.Tangents)
+	cm.mBitangents = marshalVec3s(m.BitTangents)
+
+	for i := 0; i < MaxColorSets; i++ {
+		cm.mColors[i] = marshalColors(m.ColorSets[i])
+	}
+
+	for i := 0; i < MaxTexCoords; i++ {
+
+		cm.mTextureCoords[i] = marshalVec3s(m.TexCoords[i])
+		if cm.mTextureCoords[i] == nil {
+			continue
+		}
+
+		//Meshes created in Go might not set the channel count
+		cm.mNumUVComponents[i] = C.uint(m.TexCoordChannelCount[i])
+		if cm.mNumUVComponents[i] == 0 {
+			cm.mNumUVComponents[i] = 2
+		}
+	}
+
+	primitiveTypes := m.PrimitiveTypes
+	cm.mNumFaces = C.uint(len(m.Faces))
+	if len(m.Faces) > 0 {
+
+		cm.mFaces = C.asig_cpp_new_faces(C.uint(len(m.Faces)))
+		cFaces := unsafe.Slice(cm.mFaces, len(m.Faces))
+		for i, f := range m.Faces {
+
+			cFaces[i].mNumIndices = C.uint(len(f.Indices))
+			cFaces[i].mIndices = marshalUInts(f.Indices)
+
+			if m.PrimitiveTypes == 0 {
+				primitiveTypes |= facePrimitiveType(len(f.Indices))
+			}
+		}
+	}
+	cm.mPrimitiveTypes = C.uint(primitiveTypes)
+
+	cm.mNumBones = C.uint(len(m.Bones))
+	cm.mBones = C.asig_cpp_new_bone_ptrs(C.uint(len(m.Bones)))
+	cBones := unsafe.Slice(cm.mBones, len(m.Bones))
+	for i, b := range m.Bones {
+		cBones[i] = marshalBone(b)
+	}
+
+	cm.mAABB.mMin = toAiVec3(&m.AABB.Min)
+	cm.mAABB.mMax = toAiVec3(&m.AABB.Max)
+
+	return cm
+}
+
+func facePrimitiveType(indexCount int) PrimitiveType {
+
+	switch indexCount {
+	case 0:
+		return 0
+	case 1:
+		return PrimitiveTypePoint
+	case 2:
+		return PrimitiveTypeLine
+	case 3:
+		return PrimitiveTypeTriangle
+	default:
+		return PrimitiveTypePolygon
+	}
+}
+
+func marshalBone(b *Bone) *C.struct_aiBone {
+
+	cb := C.asig_cpp_new_bone()
+	cb.mName = toAiString(b.Name)
+	cb.mOffsetMatrix = toAiMat4(&b.OffsetMatrix)
+
+	cb.mNumWeights = C.uint(len(b.Weights))
+	if len(b.Weights) > 0 {
+
+		cb.mWeights = C.asig_cpp_new_weights(C.uint(len(b.Weights)))
+		cWeights := unsafe.Slice(cb.mWeights, len(b.Weights))
+		for i, w := range b.Weights {
+			cWeights[i].mVertexId = C.uint(w.VertIndex)
+			cWeights[i].mWeight = C.ai_real(w.Weight)
+		}
+	}
+
+	return cb
+}
+
+func marshalMaterial(m *Material) *C.struct_aiMaterial {
+
+	cMat := C.asig_cpp_new_material(C.uint(len(m.Properties)))
+	cMat.mNumProperties = C.uint(len(m.Properties))
+
+	cProps := unsafe.Slice(cMat.mProperties, len(m.Properties))
+	for i, p := range m.Properties {
+
+		cp := C.asig_cpp_new_material_property()
+		cp.mKey = toAiString(p.Name)
+		cp.mSemantic = C.uint(p.Semantic)
+		cp.mIndex = C.uint(p.Index)
+		cp.mType = uint32(p.TypeInfo)
+		cp.mDataLength = C.uint(len(p.Data))
+		cp.mData = marshalBytes(p.Data)
+
+		cProps[i] = cp
+	}
+
+	return cMat
+}
+
+func marshalTexture(t *EmbeddedTexture) *C.struct_aiTexture {
+
+	ct := C.asig_cpp_new_texture()
+	ct.mWidth = C.uint(t.Width)
+	ct.mHeight = C.uint(t.Height)
+	ct.mFilename = toAiString(t.Filename)
+	ct.pcData = marshalTexels(t.Data)
+
+	//The hint has room for 8 characters and a null terminator
+	for i := 0; i < len(t.FormatHint) && i < len(ct.achFormatHint)-1; i++ {
+		ct.achFormatHint[i] = C.char(t.FormatHint[i])
+	}
+
+	return ct
+}
+
+func marshalBytes(data []byte) *C.char {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	cData := C.asig_cpp_new_bytes(C.uint(len(data)))
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(cData)), len(data)), data)
+	return cData
+}
+
+//marshalTexels copies the data of a texture, which is texels for uncompressed textures and the bytes of the file otherwise
+func marshalTexels(data []byte) *C.struct_aiTexel {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	cData := C.asig_cpp_new_texels(C.uint(len(data)))
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(cData)), len(data)), data)
+	return cData
+}
+
+func marshalUInts(uints []uint) *C.uint {
+
+	if len(uints) == 0 {
+		return nil
+	}
+
+	cUInts := C.asig_cpp_new_uints(C.uint(len(uints)))
+	cSlice := unsafe.Slice(cUInts, len(uints))
+	for i, u := range uints {
+		cSlice[i] = C.uint(u)
+	}
+
+	return cUInts
+}
+
+func marshalVec3s(vecs []gglm.Vec3) *C.struct_aiVector3D {
+
+	if len(vecs) == 0 {
+		return nil
+	}
+
+	cVecs := C.asig_cpp_new_vec3s(C.uint(len(vecs)))
+	cSlice := unsafe.Slice(cVecs, len(vecs))
+	for i := range vecs {
+		cSlice[i] = toAiVec3(&vecs[i])
+	}
+
+	return cVecs
+}
+
+func marshalColors(colors []gglm.Vec4) *C.struct_aiColor4D {
+
+	if len(colors) == 0 {
+		return nil
+	}
+
+	cColors := C.asig_cpp_new_colors(C.uint(len(colors)))
+	cSlice := unsafe.Slice(cColors, len(colors))
+	for i, c := range colors {
+		cSlice[i] = C.struct_aiColor4D{
+			r: C.ai_real(c.Data[0]),
+			g: C.ai_real(c.Data[1]),
+			b: C.ai_real(c.Data[2]),
+			a: C.ai_real(c.Data[3]),
+		}
+	}
+
+	return cColors
+}
+
+func toAiVec3(v *gglm.Vec3) C.struct_aiVector3D {
+	return C.struct_aiVector3D{
+		x: C.ai_real(v.Data[0]),
+		y: C.ai_real(v.Data[1]),
+		z: C.ai_real(v.Data[2]),
+	}
+}
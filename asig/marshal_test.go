@@ -0,0 +1,75 @@
+package asig
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bloeys/gglm/gglm"
+)
+
+func TestValidateForMarshal(t *testing.T) {
+
+	newMesh := func(materialIndex uint) *Mesh {
+		return &Mesh{
+			Name:          "quad",
+			Vertices:      make([]gglm.Vec3, 3),
+			Faces:         []Face{{Indices: []uint{0, 1, 2}}},
+			MaterialIndex: materialIndex,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		s       *Scene
+		wantErr string
+	}{
+		{
+			name: "valid",
+			s:    &Scene{RootNode: &Node{MeshIndicies: []uint{0}}, Meshes: []*Mesh{newMesh(0)}, Materials: []*Material{{}}},
+		},
+		{
+			name: "no meshes and no materials",
+			s:    &Scene{RootNode: &Node{}},
+		},
+		{
+			name:    "no root node",
+			s:       &Scene{Materials: []*Material{{}}},
+			wantErr: "without a root node",
+		},
+		{
+			name:    "mesh without materials",
+			s:       &Scene{RootNode: &Node{}, Meshes: []*Mesh{newMesh(0)}},
+			wantErr: "has no materials",
+		},
+		{
+			name:    "material index out of range",
+			s:       &Scene{RootNode: &Node{}, Meshes: []*Mesh{newMesh(1)}, Materials: []*Material{{}}},
+			wantErr: "material index is 1",
+		},
+		{
+			name:    "node mesh out of range",
+			s:       &Scene{RootNode: &Node{Name: "root", MeshIndicies: []uint{1}}, Meshes: []*Mesh{newMesh(0)}, Materials: []*Material{{}}},
+			wantErr: "uses mesh 1",
+		},
+		{
+			name:    "face index out of range",
+			s:       &Scene{RootNode: &Node{}, Meshes: []*Mesh{{Vertices: make([]gglm.Vec3, 2), Faces: []Face{{Indices: []uint{0, 1, 2}}}}}, Materials: []*Material{{}}},
+			wantErr: "has index 2",
+		},
+	}
+
+	for _, tt := range tests {
+
+		err := tt.s.validateForMarshal()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: validateForMarshal() = %v, want nil", tt.name, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: validateForMarshal() = %v, want an error containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -2,9 +2,6 @@ package asig
 
 /*
 #cgo CFLAGS: -I .
-#cgo LDFLAGS: -L libs
-#cgo windows,amd64 LDFLAGS: -l assimp_windows_amd64
-#cgo darwin,arm64 LDFLAGS: -l assimp_darwin_arm64
 
 #include "wrap.c"
 #include <stdlib.h>
@@ -13,10 +10,18 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"unsafe"
+
+	"github.com/bloeys/gglm/gglm"
+)
+
+var (
+	ErrMatPropertyNotFound = errors.New("material property not found")
 )
 
 type Material struct {
-	cMat *C.struct_aiMaterial
+	cScene *cSceneRef
+	cMat   *C.struct_aiMaterial
 
 	/** List of all material properties loaded. */
 	Properties []*MaterialProperty
@@ -28,7 +33,7 @@ type Material struct {
 type MaterialProperty struct {
 
 	//Specifies the name of the property (aka key). Keys are generally case insensitive.
-	name string
+	Name string
 
 	/** Textures: Specifies their exact usage semantic.
 	 * For non-texture properties, this member is always 0 (aka TextureTypeNone).
@@ -53,31 +58,291 @@ type MaterialProperty struct {
 	Data []byte
 }
 
+//GetMaterialTextureCount returns the number of textures of the given type. It returns 0 if the scene was released
 func GetMaterialTextureCount(m *Material, texType TextureType) int {
+
+	if err := m.cScene.rlock(); err != nil {
+		return 0
+	}
+	defer m.cScene.runlock()
+
 	return int(C.aiGetMaterialTextureCount(m.cMat, uint32(texType)))
 }
 
+//GetMatTexInfo describes how a texture is bound to a material
 type GetMatTexInfo struct {
+	//Path of the texture. Embedded textures have paths like '*0', which is an index into Scene.Textures
 	Path string
+
+	//How the texture coordinates are generated
+	Mapping TextureMapping
+
+	//Index of the UV channel (e.g. Mesh.TexCoords[UVIndex]) used by the texture
+	UVIndex uint
+
+	//Strength of the texture when combining it with the previous layers. Defaults to 1
+	Blend float32
+
+	//How the texture is combined with the previous layers
+	Op TextureOp
+
+	//Wrap modes in the U and V directions
+	MapModeU TextureMapMode
+	MapModeV TextureMapMode
+
+	Flags TextureFlags
 }
 
 func GetMaterialTexture(m *Material, texType TextureType, texIndex uint) (*GetMatTexInfo, error) {
 
+	if err := m.cScene.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.cScene.runlock()
+
 	outCPath := &C.struct_aiString{}
-	status := aiReturn(C.aiGetMaterialTexture(m.cMat, uint32(texType), C.uint(texIndex), outCPath, nil, nil, nil, nil, nil, nil))
-	if status == aiReturnSuccess {
-		return &GetMatTexInfo{
-			Path: parseAiString(*outCPath),
-		}, nil
+
+	//Assimp leaves outputs untouched for missing properties, so these hold the defaults
+	var mapping C.enum_aiTextureMapping = C.aiTextureMapping_UV
+	var uvIndex C.uint = 0
+	var blend C.ai_real = 1
+	var op C.enum_aiTextureOp = C.aiTextureOp_Multiply
+	var mapModes [2]C.enum_aiTextureMapMode = [2]C.enum_aiTextureMapMode{C.aiTextureMapMode_Wrap, C.aiTextureMapMode_Wrap}
+	var flags C.uint = 0
+
+	status := aiReturn(C.aiGetMaterialTexture(m.cMat, uint32(texType), C.uint(texIndex), outCPath, &mapping, &uvIndex, &blend, &op, &mapModes[0], &flags))
+	if status != aiReturnSuccess {
+		return nil, matGetErr("get texture", status)
+	}
+
+	return &GetMatTexInfo{
+		Path:     parseAiString(*outCPath),
+		Mapping:  TextureMapping(mapping),
+		UVIndex:  uint(uvIndex),
+		Blend:    float32(blend),
+		Op:       TextureOp(op),
+		MapModeU: TextureMapMode(mapModes[0]),
+		MapModeV: TextureMapMode(mapModes[1]),
+		Flags:    TextureFlags(flags),
+	}, nil
+}
+
+//UVTransform defines how the UV coordinates of a texture are transformed before sampling
+type UVTransform struct {
+	//Translation on the u and v axes. Default (0,0)
+	Translation gglm.Vec2
+
+	//Scaling on the u and v axes. Default (1,1)
+	Scaling gglm.Vec2
+
+	//Counter-clockwise rotation in radians around (0.5,0.5). Default 0
+	Rotation float32
+}
+
+//GetMaterialColor returns the color stored under key. RGB colors are returned with an alpha of 1
+func GetMaterialColor(m *Material, key MatKey, texType TextureType, texIndex uint) (*gglm.Vec4, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	outColor := &C.struct_aiColor4D{}
+	status := aiReturn(C.aiGetMaterialColor(m.cMat, cKey, C.uint(texType), C.uint(texIndex), outColor))
+	if status != aiReturnSuccess {
+		return nil, matGetErr("get color", status)
+	}
+
+	return &gglm.Vec4{Data: [4]float32{float32(outColor.r), float32(outColor.g), float32(outColor.b), float32(outColor.a)}}, nil
+}
+
+//GetMaterialFloat returns the first float stored under key. Integer properties are converted
+func GetMaterialFloat(m *Material, key MatKey, texType TextureType, texIndex uint) (float32, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return 0, err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	var out C.ai_real
+	status := aiReturn(C.aiGetMaterialFloatArray(m.cMat, cKey, C.uint(texType), C.uint(texIndex), &out, nil))
+	if status != aiReturnSuccess {
+		return 0, matGetErr("get float", status)
+	}
+
+	return float32(out), nil
+}
+
+//GetMaterialFloatArray returns all floats stored under key. Integer properties are converted
+func GetMaterialFloatArray(m *Material, key MatKey, texType TextureType, texIndex uint) ([]float32, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	out := make([]C.ai_real, m.propertyValueCount(key, texType, texIndex))
+	outCount := C.uint(len(out))
+	status := aiReturn(C.aiGetMaterialFloatArray(m.cMat, cKey, C.uint(texType), C.uint(texIndex), &out[0], &outCount))
+	if status != aiReturnSuccess {
+		return nil, matGetErr("get float array", status)
+	}
+
+	floats := make([]float32, outCount)
+	for i := 0; i < len(floats); i++ {
+		floats[i] = float32(out[i])
+	}
+
+	return floats, nil
+}
+
+//GetMaterialInt returns the first integer stored under key. Float properties are converted
+func GetMaterialInt(m *Material, key MatKey, texType TextureType, texIndex uint) (int32, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return 0, err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	var out C.int
+	status := aiReturn(C.aiGetMaterialIntegerArray(m.cMat, cKey, C.uint(texType), C.uint(texIndex), &out, nil))
+	if status != aiReturnSuccess {
+		return 0, matGetErr("get int", status)
+	}
+
+	return int32(out), nil
+}
+
+//GetMaterialIntArray returns all integers stored under key. Float properties are converted
+func GetMaterialIntArray(m *Material, key MatKey, texType TextureType, texIndex uint) ([]int32, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	out := make([]C.int, m.propertyValueCount(key, texType, texIndex))
+	outCount := C.uint(len(out))
+	status := aiReturn(C.aiGetMaterialIntegerArray(m.cMat, cKey, C.uint(texType), C.uint(texIndex), &out[0], &outCount))
+	if status != aiReturnSuccess {
+		return nil, matGetErr("get int array", status)
+	}
+
+	ints := make([]int32, outCount)
+	for i := 0; i < len(ints); i++ {
+		ints[i] = int32(out[i])
+	}
+
+	return ints, nil
+}
+
+//GetMaterialBool returns true if the integer stored under key is not zero
+func GetMaterialBool(m *Material, key MatKey, texType TextureType, texIndex uint) (bool, error) {
+
+	i, err := GetMaterialInt(m, key, texType, texIndex)
+	return i != 0, err
+}
+
+//GetMaterialString returns the string stored under key, like MatKeyName or MatKeyTexture
+func GetMaterialString(m *Material, key MatKey, texType TextureType, texIndex uint) (string, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return "", err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	outStr := &C.struct_aiString{}
+	status := aiReturn(C.aiGetMaterialString(m.cMat, cKey, C.uint(texType), C.uint(texIndex), outStr))
+	if status != aiReturnSuccess {
+		return "", matGetErr("get string", status)
 	}
 
+	return parseAiString(*outStr), nil
+}
+
+//GetMaterialUVTransform returns the UV transform of a texture. Use MatKeyUVTransform as the key
+func GetMaterialUVTransform(m *Material, key MatKey, texType TextureType, texIndex uint) (*UVTransform, error) {
+
+	if err := m.cScene.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.cScene.runlock()
+
+	cKey := C.CString(string(key))
+	defer C.free(unsafe.Pointer(cKey))
+
+	outTrans := &C.struct_aiUVTransform{}
+	status := aiReturn(C.aiGetMaterialUVTransform(m.cMat, cKey, C.uint(texType), C.uint(texIndex), outTrans))
+	if status != aiReturnSuccess {
+		return nil, matGetErr("get uv transform", status)
+	}
+
+	return &UVTransform{
+		Translation: gglm.Vec2{Data: [2]float32{float32(outTrans.mTranslation.x), float32(outTrans.mTranslation.y)}},
+		Scaling:     gglm.Vec2{Data: [2]float32{float32(outTrans.mScaling.x), float32(outTrans.mScaling.y)}},
+		Rotation:    float32(outTrans.mRotation),
+	}, nil
+}
+
+//Property returns the property with the given key, texture type and index, or nil if none is found
+func (m *Material) Property(key MatKey, texType TextureType, texIndex uint) *MaterialProperty {
+
+	for _, p := range m.Properties {
+		if p.Name == string(key) && p.Semantic == texType && p.Index == texIndex {
+			return p
+		}
+	}
+
+	return nil
+}
+
+//propertyValueCount returns an upper bound on the number of array elements stored under a key, and never less than 1
+func (m *Material) propertyValueCount(key MatKey, texType TextureType, texIndex uint) int {
+
+	p := m.Property(key, texType, texIndex)
+	if p == nil {
+		return 1
+	}
+
+	count := len(p.Data) / 4
+	if p.TypeInfo == MatPropTypeInfoFloat64 {
+		count = len(p.Data) / 8
+	}
+
+	if count < 1 {
+		return 1
+	}
+
+	return count
+}
+
+func matGetErr(op string, status aiReturn) error {
+
 	if status == aiReturnFailure {
-		return nil, errors.New("get texture failed: " + getAiErr().Error())
+		return fmt.Errorf("%s failed: %w", op, ErrMatPropertyNotFound)
 	}
 
 	if status == aiReturnOutofMemory {
-		return nil, errors.New("get texture failed: out of memory")
+		return errors.New(op + " failed: out of memory")
 	}
 
-	return nil, errors.New("get texture failed: unknown error with code " + fmt.Sprintf("%v", status))
+	return errors.New(op + " failed: unknown error with code " + fmt.Sprintf("%v", status))
 }
@@ -0,0 +1,32 @@
+package asig
+
+import (
+	"errors"
+	"testing"
+)
+
+//Material getters must fail on materials of released scenes instead of reading freed memory
+func TestMaterialGettersOfReleasedScene(t *testing.T) {
+
+	m := &Material{cScene: &cSceneRef{}}
+
+	if _, err := GetMaterialTexture(m, TextureTypeDiffuse, 0); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("GetMaterialTexture() error = %v, want %v", err, ErrSceneReleased)
+	}
+
+	if _, err := GetMaterialColor(m, MatKeyColorDiffuse, TextureTypeNone, 0); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("GetMaterialColor() error = %v, want %v", err, ErrSceneReleased)
+	}
+
+	if _, err := GetMaterialFloat(m, MatKeyShininess, TextureTypeNone, 0); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("GetMaterialFloat() error = %v, want %v", err, ErrSceneReleased)
+	}
+
+	if _, err := GetMaterialString(m, MatKeyName, TextureTypeNone, 0); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("GetMaterialString() error = %v, want %v", err, ErrSceneReleased)
+	}
+
+	if count := GetMaterialTextureCount(m, TextureTypeDiffuse); count != 0 {
+		t.Errorf("GetMaterialTextureCount() = %d, want 0", count)
+	}
+}
@@ -0,0 +1,142 @@
+package asig
+
+//MatKey is the name of a material property (an AI_MATKEY_XXX key in assimp/material.h).
+//
+//Keys that are not tied to a texture must be queried with TextureTypeNone and index 0, while texture keys
+//(e.g. MatKeyTexture or MatKeyUVWSrc) take the texture type and texture index the property belongs to.
+type MatKey string
+
+//General
+const (
+	//Name of the material. String
+	MatKeyName MatKey = "?mat.name"
+
+	//Whether backface culling must be disabled for this material. Int (bool)
+	MatKeyTwoSided MatKey = "$mat.twosided"
+
+	//Shading model to use (see ShadingMode). Int
+	MatKeyShadingModel MatKey = "$mat.shadingm"
+
+	//Whether the material must be rendered as wireframe. Int (bool)
+	MatKeyEnableWireframe MatKey = "$mat.wireframe"
+
+	//Blend function used to combine the material color with the framebuffer (see BlendMode). Int
+	MatKeyBlendFunc MatKey = "$mat.blend"
+
+	//Opacity of the material, 1 being fully opaque. Float
+	MatKeyOpacity MatKey = "$mat.opacity"
+
+	//Transparency factor, 0 being fully opaque. Float
+	MatKeyTransparencyFactor MatKey = "$mat.transparencyfactor"
+
+	//Scaling factor applied to bump maps. Float
+	MatKeyBumpScaling MatKey = "$mat.bumpscaling"
+
+	//Exponent of the phong specular equation. Float
+	MatKeyShininess MatKey = "$mat.shininess"
+
+	//Reflectivity of the material. Float
+	MatKeyReflectivity MatKey = "$mat.reflectivity"
+
+	//Scales the specular color of the material. Float
+	MatKeyShininessStrength MatKey = "$mat.shinpercent"
+
+	//Index of refraction of the material. Float
+	MatKeyRefractI MatKey = "$mat.refracti"
+)
+
+//Colors
+const (
+	MatKeyColorDiffuse     MatKey = "$clr.diffuse"
+	MatKeyColorAmbient     MatKey = "$clr.ambient"
+	MatKeyColorSpecular    MatKey = "$clr.specular"
+	MatKeyColorEmissive    MatKey = "$clr.emissive"
+	MatKeyColorTransparent MatKey = "$clr.transparent"
+	MatKeyColorReflective  MatKey = "$clr.reflective"
+)
+
+//Global background image and shaders
+const (
+	MatKeyGlobalBackgroundImage MatKey = "?bg.global"
+	MatKeyGlobalShaderLang      MatKey = "?sh.lang"
+	MatKeyShaderVertex          MatKey = "?sh.vs"
+	MatKeyShaderFragment        MatKey = "?sh.fs"
+	MatKeyShaderGeo             MatKey = "?sh.gs"
+	MatKeyShaderTesselation     MatKey = "?sh.ts"
+	MatKeyShaderPrimitive       MatKey = "?sh.ps"
+	MatKeyShaderCompute         MatKey = "?sh.cs"
+)
+
+//Texture keys. These must be queried with the texture type and index they belong to
+const (
+	//Path of the texture. String
+	MatKeyTexture MatKey = "$tex.file"
+
+	//UV channel used by the texture. Int
+	MatKeyUVWSrc MatKey = "$tex.uvwsrc"
+
+	//Operation used to combine the texture with the previous one (see TextureOp). Int
+	MatKeyTexOp MatKey = "$tex.op"
+
+	//How texture coordinates are generated (see TextureMapping). Int
+	MatKeyMapping MatKey = "$tex.mapping"
+
+	//Strength of the texture in the texture stack. Float
+	MatKeyTexBlend MatKey = "$tex.blend"
+
+	//Wrap mode in the U direction (see TextureMapMode). Int
+	MatKeyMappingModeU MatKey = "$tex.mapmodeu"
+
+	//Wrap mode in the V direction (see TextureMapMode). Int
+	MatKeyMappingModeV MatKey = "$tex.mapmodev"
+
+	//Axis used by non-UV mappings. Vec3
+	MatKeyTexMapAxis MatKey = "$tex.mapaxis"
+
+	//Transformation of the texture coordinates (see GetMaterialUVTransform)
+	MatKeyUVTransform MatKey = "$tex.uvtrafo"
+
+	//Texture flags (see TextureFlags). Int
+	MatKeyTexFlags MatKey = "$tex.flags"
+)
+
+//glTF keys (assimp/pbrmaterial.h). These are only set by the glTF importers
+const (
+	//Base color of the metallic-roughness model. Color4
+	MatKeyGLTFBaseColorFactor MatKey = "$mat.gltf.pbrMetallicRoughness.baseColorFactor"
+
+	//Metalness of the material. Float
+	MatKeyGLTFMetallicFactor MatKey = "$mat.gltf.pbrMetallicRoughness.metallicFactor"
+
+	//Roughness of the material. Float
+	MatKeyGLTFRoughnessFactor MatKey = "$mat.gltf.pbrMetallicRoughness.roughnessFactor"
+
+	//One of 'OPAQUE', 'MASK' or 'BLEND'. String
+	MatKeyGLTFAlphaMode MatKey = "$mat.gltf.alphaMode"
+
+	//Alpha below which fragments are discarded when the alpha mode is 'MASK'. Float
+	MatKeyGLTFAlphaCutoff MatKey = "$mat.gltf.alphaCutoff"
+
+	//Set if the material uses the specular-glossiness model. Int (bool)
+	MatKeyGLTFPBRSpecularGlossiness MatKey = "$mat.gltf.pbrSpecularGlossiness"
+
+	//Glossiness of the specular-glossiness model. Float
+	MatKeyGLTFGlossinessFactor MatKey = "$mat.gltf.pbrMetallicRoughness.glossinessFactor"
+
+	//Set if the material is unlit (KHR_materials_unlit). Int (bool)
+	MatKeyGLTFUnlit MatKey = "$mat.gltf.unlit"
+)
+
+//glTF texture keys. These must be queried with the texture type and index they belong to.
+//
+//The glTF importers store the base color texture as TextureTypeDiffuse (index 0 and 1) and the
+//metallic-roughness texture as TextureTypeUnknown (index 0)
+const (
+	MatKeyGLTFTextureTexCoord  MatKey = "$tex.file.texCoord"
+	MatKeyGLTFMappingName      MatKey = "$tex.mappingname"
+	MatKeyGLTFMappingID        MatKey = "$tex.mappingid"
+	MatKeyGLTFMappingFilterMag MatKey = "$tex.mappingfiltermag"
+	MatKeyGLTFMappingFilterMin MatKey = "$tex.mappingfiltermin"
+	MatKeyGLTFTextureScale     MatKey = "$tex.scale"
+	MatKeyGLTFTextureStrength  MatKey = "$tex.strength"
+)
@@ -0,0 +1,128 @@
+package asig
+
+//Common scene metadata keys. Which keys are present depends on the importer, with FBX filling most of them
+const (
+	MetadataKeyUnitScaleFactor         = "UnitScaleFactor"
+	MetadataKeyOriginalUnitScaleFactor = "OriginalUnitScaleFactor"
+	MetadataKeyUpAxis                  = "UpAxis"
+	MetadataKeyUpAxisSign              = "UpAxisSign"
+	MetadataKeyFrontAxis               = "FrontAxis"
+	MetadataKeyFrontAxisSign           = "FrontAxisSign"
+	MetadataKeyCoordAxis               = "CoordAxis"
+	MetadataKeyCoordAxisSign           = "CoordAxisSign"
+	MetadataKeyFrameRate               = "FrameRate"
+	MetadataKeyCustomFrameRate         = "CustomFrameRate"
+	MetadataKeySourceGenerator         = "SourceAsset_Generator"
+	MetadataKeySourceFormat            = "SourceAsset_Format"
+	MetadataKeySourceFormatVersion     = "SourceAsset_FormatVersion"
+	MetadataKeySourceCopyright         = "SourceAsset_Copyright"
+)
+
+//UnitScaleFactor returns how many centimeters one unit of the file is (e.g. 1 for centimeters and 100 for meters).
+//ok is false if the importer didn't provide it
+func (s *Scene) UnitScaleFactor() (scale float64, ok bool) {
+	return metadataFloat(s.Metadata, MetadataKeyUnitScaleFactor)
+}
+
+//UpAxis returns the axis (and its sign, 1 or -1) that points up in the file
+func (s *Scene) UpAxis() (axis Axis, sign int32, ok bool) {
+	return metadataAxis(s.Metadata, MetadataKeyUpAxis, MetadataKeyUpAxisSign)
+}
+
+//FrontAxis returns the axis (and its sign, 1 or -1) that points to the front in the file
+func (s *Scene) FrontAxis() (axis Axis, sign int32, ok bool) {
+	return metadataAxis(s.Metadata, MetadataKeyFrontAxis, MetadataKeyFrontAxisSign)
+}
+
+//CoordAxis returns the axis (and its sign, 1 or -1) that is the third (usually right) axis of the file's coordinate system
+func (s *Scene) CoordAxis() (axis Axis, sign int32, ok bool) {
+	return metadataAxis(s.Metadata, MetadataKeyCoordAxis, MetadataKeyCoordAxisSign)
+}
+
+//FrameRate returns the frame rate stored in the file. For FBX files this is the FBX time mode enum,
+//where a value of 14 (custom) means the actual rate is in CustomFrameRate
+func (s *Scene) FrameRate() (frameRate int64, ok bool) {
+	return metadataInt(s.Metadata, MetadataKeyFrameRate)
+}
+
+//CustomFrameRate returns the frames per second of files using a custom frame rate
+func (s *Scene) CustomFrameRate() (fps float64, ok bool) {
+	return metadataFloat(s.Metadata, MetadataKeyCustomFrameRate)
+}
+
+//SourceGenerator returns the name of the application that created the file
+func (s *Scene) SourceGenerator() (generator string, ok bool) {
+	return metadataString(s.Metadata, MetadataKeySourceGenerator)
+}
+
+//SourceFormat returns the name of the file format as reported by the importer (e.g. "Autodesk FBX Importer")
+func (s *Scene) SourceFormat() (format string, ok bool) {
+	return metadataString(s.Metadata, MetadataKeySourceFormat)
+}
+
+//metadataFloat returns the value of key as a float64 if it exists and is numeric
+func metadataFloat(meta map[string]Metadata, key string) (float64, bool) {
+
+	m, ok := meta[key]
+	if !ok {
+		return 0, false
+	}
+
+	switch v := m.Value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case int32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+//metadataInt returns the value of key as an int64 if it exists and is an integer
+func metadataInt(meta map[string]Metadata, key string) (int64, bool) {
+
+	m, ok := meta[key]
+	if !ok {
+		return 0, false
+	}
+
+	switch v := m.Value.(type) {
+	case int32:
+		return int64(v), true
+	case uint64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func metadataString(meta map[string]Metadata, key string) (string, bool) {
+
+	m, ok := meta[key]
+	if !ok {
+		return "", false
+	}
+
+	str, ok := m.Value.(string)
+	return str, ok
+}
+
+func metadataAxis(meta map[string]Metadata, axisKey, signKey string) (axis Axis, sign int32, ok bool) {
+
+	a, ok := metadataInt(meta, axisKey)
+	if !ok {
+		return 0, 0, false
+	}
+
+	//Sign defaults to positive if missing
+	sign = 1
+	if s, ok := metadataInt(meta, signKey); ok && s < 0 {
+		sign = -1
+	}
+
+	return Axis(a), sign, true
+}
@@ -0,0 +1,48 @@
+package asig
+
+import "github.com/bloeys/gglm/gglm"
+
+//FindNode returns the first node with the given name in the hierarchy starting at (and including) n, or nil if none is found
+func (n *Node) FindNode(name string) *Node {
+
+	if n.Name == name {
+		return n
+	}
+
+	for i := 0; i < len(n.Children); i++ {
+
+		if found := n.Children[i].FindNode(name); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+//WorldTransform returns the transformation of the node relative to the root of the scene,
+//which is the product of the transformations of the node and all its parents
+func (n *Node) WorldTransform() *gglm.Mat4 {
+
+	world := n.Transformation.Clone()
+	for p := n.Parent; p != nil; p = p.Parent {
+		world = p.Transformation.Clone().Mul(world)
+	}
+
+	return world
+}
+
+//FindNode returns the first node with the given name in the scene, or nil if none is found
+func (s *Scene) FindNode(name string) *Node {
+
+	if s.RootNode == nil {
+		return nil
+	}
+
+	return s.RootNode.FindNode(name)
+}
+
+//LightNode returns the node that positions the light l in the scene, which is the node with the same name as the light.
+//The world transform of the light is LightNode(l).WorldTransform(). Returns nil if no such node exists
+func (s *Scene) LightNode(l *Light) *Node {
+	return s.FindNode(l.Name)
+}
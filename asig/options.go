@@ -0,0 +1,223 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include "wrap.c"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"unsafe"
+
+	"github.com/bloeys/gglm/gglm"
+)
+
+//ImportOptions holds import properties that tweak how importers and post processing steps behave.
+//The zero value has no properties set, in which case assimp defaults are used.
+//
+//The typed setters cover the common properties, while SetInt/SetFloat/SetBool/SetString/SetMatrix can set any ConfigKey.
+//All setters return the options so calls can be chained.
+type ImportOptions struct {
+	ints     map[ConfigKey]int32
+	floats   map[ConfigKey]float32
+	strings  map[ConfigKey]string
+	matrices map[ConfigKey]gglm.Mat4
+}
+
+func NewImportOptions() *ImportOptions {
+	return &ImportOptions{}
+}
+
+func (o *ImportOptions) SetInt(key ConfigKey, val int32) *ImportOptions {
+
+	if o.ints == nil {
+		o.ints = map[ConfigKey]int32{}
+	}
+
+	o.ints[key] = val
+	return o
+}
+
+//SetBool sets a bool property. Assimp stores bools as ints, so this is the same as SetInt with 0 or 1
+func (o *ImportOptions) SetBool(key ConfigKey, val bool) *ImportOptions {
+
+	if val {
+		return o.SetInt(key, 1)
+	}
+
+	return o.SetInt(key, 0)
+}
+
+func (o *ImportOptions) SetFloat(key ConfigKey, val float32) *ImportOptions {
+
+	if o.floats == nil {
+		o.floats = map[ConfigKey]float32{}
+	}
+
+	o.floats[key] = val
+	return o
+}
+
+func (o *ImportOptions) SetString(key ConfigKey, val string) *ImportOptions {
+
+	if o.strings == nil {
+		o.strings = map[ConfigKey]string{}
+	}
+
+	o.strings[key] = val
+	return o
+}
+
+func (o *ImportOptions) SetMatrix(key ConfigKey, val *gglm.Mat4) *ImportOptions {
+
+	if o.matrices == nil {
+		o.matrices = map[ConfigKey]gglm.Mat4{}
+	}
+
+	o.matrices[key] = *val
+	return o
+}
+
+//SetSmoothingAngle sets the max angle (in degrees) between face normals that are smoothed together by PostProcessGenSmoothNormals.
+//Max is 175, which is also the default
+func (o *ImportOptions) SetSmoothingAngle(degrees float32) *ImportOptions {
+	return o.SetFloat(ConfigGenSmoothNormalsMaxSmoothingAngle, degrees)
+}
+
+//SetTangentSmoothingAngle sets the max angle (in degrees) between tangents that are smoothed together by PostProcessCalcTangentSpace.
+//Max is 175, default is 45
+func (o *ImportOptions) SetTangentSmoothingAngle(degrees float32) *ImportOptions {
+	return o.SetFloat(ConfigCalcTangentsMaxSmoothingAngle, degrees)
+}
+
+//SetLimitBoneWeightsMax sets the max number of bones that can affect a single vertex with PostProcessLimitBoneWeights. Default is 4
+func (o *ImportOptions) SetLimitBoneWeightsMax(maxWeights int32) *ImportOptions {
+	return o.SetInt(ConfigLimitBoneWeightsMaxWeights, maxWeights)
+}
+
+//SetSplitLargeMeshesLimits sets the max vertices and triangles a mesh can have before it gets split by PostProcessSplitLargeMeshes.
+//Default for both is 1000000
+func (o *ImportOptions) SetSplitLargeMeshesLimits(maxVertices, maxTriangles int32) *ImportOptions {
+	o.SetInt(ConfigSplitLargeMeshesVertexLimit, maxVertices)
+	return o.SetInt(ConfigSplitLargeMeshesTriangleLimit, maxTriangles)
+}
+
+//SetRemoveComponents sets the parts of the scene removed by PostProcessRemoveComponent
+func (o *ImportOptions) SetRemoveComponents(components Component) *ImportOptions {
+	return o.SetInt(ConfigRemoveComponentFlags, int32(components))
+}
+
+//SetSortByPTypeRemove sets the primitive types (e.g. PrimitiveTypePoint|PrimitiveTypeLine) that get removed by PostProcessSortByPType
+func (o *ImportOptions) SetSortByPTypeRemove(primitiveTypes PrimitiveType) *ImportOptions {
+	return o.SetInt(ConfigSortByPTypeRemove, int32(primitiveTypes))
+}
+
+//SetGlobalScale sets the scale applied to the scene by PostProcessGlobalScale. Default is 1
+func (o *ImportOptions) SetGlobalScale(scale float32) *ImportOptions {
+	return o.SetFloat(ConfigGlobalScaleFactor, scale)
+}
+
+//FBXImportOptions holds the switches of the FBX importer. Use DefaultFBXImportOptions to get assimp's defaults
+type FBXImportOptions struct {
+	//Merge all geometry layers present in the file instead of taking only the first
+	ReadAllGeometryLayers bool
+
+	//Read all materials present in the file instead of only the referenced ones. Ignored unless ReadMaterials=true
+	ReadAllMaterials bool
+
+	ReadMaterials bool
+
+	//Read embedded textures
+	ReadTextures   bool
+	ReadCameras    bool
+	ReadLights     bool
+	ReadAnimations bool
+
+	//Only support FBX 2013 and reject other sub formats
+	StrictMode bool
+
+	//Preserve pivot points for transformations (as extra nodes). Otherwise, pivots and offsets are evaluated whenever possible
+	PreservePivots bool
+
+	//Drop empty animation curves and curves that match the bind pose over their entire range
+	OptimizeEmptyAnimationCurves bool
+
+	//Use the legacy naming of embedded textures
+	EmbeddedTexturesLegacyNaming bool
+
+	//Convert the units of the file from centimeters to meters
+	ConvertToMeters bool
+}
+
+func DefaultFBXImportOptions() FBXImportOptions {
+	return FBXImportOptions{
+		ReadAllGeometryLayers:        true,
+		ReadAllMaterials:             false,
+		ReadMaterials:                true,
+		ReadTextures:                 true,
+		ReadCameras:                  true,
+		ReadLights:                   true,
+		ReadAnimations:               true,
+		StrictMode:                   false,
+		PreservePivots:               true,
+		OptimizeEmptyAnimationCurves: true,
+		EmbeddedTexturesLegacyNaming: false,
+		ConvertToMeters:              false,
+	}
+}
+
+//SetFBX sets all the switches of the FBX importer
+func (o *ImportOptions) SetFBX(fbx FBXImportOptions) *ImportOptions {
+	return o.SetBool(ConfigImportFBXReadAllGeometryLayers, fbx.ReadAllGeometryLayers).
+		SetBool(ConfigImportFBXReadAllMaterials, fbx.ReadAllMaterials).
+		SetBool(ConfigImportFBXReadMaterials, fbx.ReadMaterials).
+		SetBool(ConfigImportFBXReadTextures, fbx.ReadTextures).
+		SetBool(ConfigImportFBXReadCameras, fbx.ReadCameras).
+		SetBool(ConfigImportFBXReadLights, fbx.ReadLights).
+		SetBool(ConfigImportFBXReadAnimations, fbx.ReadAnimations).
+		SetBool(ConfigImportFBXStrictMode, fbx.StrictMode).
+		SetBool(ConfigImportFBXPreservePivots, fbx.PreservePivots).
+		SetBool(ConfigImportFBXOptimizeEmptyAnimationCurves, fbx.OptimizeEmptyAnimationCurves).
+		SetBool(ConfigImportFBXEmbeddedTexturesLegacyNaming, fbx.EmbeddedTexturesLegacyNaming).
+		SetBool(ConfigFBXConvertToMeters, fbx.ConvertToMeters)
+}
+
+//toCPropStore creates an assimp property store with all the options set.
+//Returns nil if o is nil, otherwise the store must be freed with aiReleasePropertyStore
+func (o *ImportOptions) toCPropStore() *C.struct_aiPropertyStore {
+
+	if o == nil {
+		return nil
+	}
+
+	store := C.aiCreatePropertyStore()
+
+	for k, v := range o.ints {
+		cKey := C.CString(string(k))
+		C.aiSetImportPropertyInteger(store, cKey, C.int(v))
+		C.free(unsafe.Pointer(cKey))
+	}
+
+	for k, v := range o.floats {
+		cKey := C.CString(string(k))
+		C.aiSetImportPropertyFloat(store, cKey, C.ai_real(v))
+		C.free(unsafe.Pointer(cKey))
+	}
+
+	for k, v := range o.strings {
+		cKey := C.CString(string(k))
+		cStr := toAiString(v)
+		C.aiSetImportPropertyString(store, cKey, &cStr)
+		C.free(unsafe.Pointer(cKey))
+	}
+
+	for k, v := range o.matrices {
+		cKey := C.CString(string(k))
+		cMat := toAiMat4(&v)
+		C.aiSetImportPropertyMatrix(store, cKey, &cMat)
+		C.free(unsafe.Pointer(cKey))
+	}
+
+	return store
+}
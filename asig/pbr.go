@@ -0,0 +1,213 @@
+package asig
+
+import (
+	"math"
+	"strings"
+
+	"github.com/bloeys/gglm/gglm"
+)
+
+//PBRMaterial is a metallic-roughness view of a Material, following the glTF 2.0 material model.
+//Textures are nil if the material doesn't have them
+type PBRMaterial struct {
+	BaseColorFactor  gglm.Vec4
+	BaseColorTexture *GetMatTexInfo
+
+	MetallicFactor  float32
+	RoughnessFactor float32
+
+	//Combined texture with roughness in the green channel and metalness in the blue channel (glTF layout)
+	MetallicRoughnessTexture *GetMatTexInfo
+
+	//Separate metalness and roughness textures (TextureTypeMetalness/TextureTypeDiffuseRoughness),
+	//used by formats that don't pack them into MetallicRoughnessTexture
+	MetallicTexture  *GetMatTexInfo
+	RoughnessTexture *GetMatTexInfo
+
+	NormalTexture *GetMatTexInfo
+	NormalScale   float32
+
+	OcclusionTexture  *GetMatTexInfo
+	OcclusionStrength float32
+
+	EmissiveFactor  gglm.Vec3
+	EmissiveTexture *GetMatTexInfo
+
+	AlphaMode   AlphaMode
+	AlphaCutoff float32
+
+	DoubleSided bool
+
+	//Set for glTF materials using KHR_materials_unlit
+	Unlit bool
+
+	//True if the material had no PBR properties and was converted from its Phong properties (see PBRMaterialOf)
+	FromPhong bool
+}
+
+/** PBRMaterialOf returns a metallic-roughness view of the material.
+ *
+ * glTF materials (and any material with BaseColor/Metalness/DiffuseRoughness textures) are read directly.
+ * Other materials, like those from OBJ and FBX files, are converted from their Phong properties as follows:
+ *   - BaseColorFactor is the diffuse color, with alpha set to the opacity
+ *   - BaseColorTexture is the first diffuse texture
+ *   - MetallicFactor is 0, as Phong materials don't carry enough information to tell metals apart
+ *   - RoughnessFactor is sqrt(2/(shininess+2)), which maps a Blinn-Phong exponent to a roughness. It is 1 if the shininess is missing
+ *   - NormalTexture is the first normal texture, or the first height (bump) texture if there are no normal textures
+ *   - OcclusionTexture is the first ambient occlusion texture
+ *   - EmissiveFactor and EmissiveTexture are the emissive color and first emissive texture
+ *   - AlphaMode is AlphaModeBlend if the opacity is below 1 or there is an opacity texture, otherwise AlphaModeOpaque
+ *
+ * ErrSceneReleased is returned if the scene of the material was released.
+ */
+func PBRMaterialOf(m *Material) (*PBRMaterial, error) {
+
+	if err := m.cScene.checkReleased(); err != nil {
+		return nil, err
+	}
+
+	pbr := &PBRMaterial{
+		BaseColorFactor:   gglm.Vec4{Data: [4]float32{1, 1, 1, 1}},
+		MetallicFactor:    1,
+		RoughnessFactor:   1,
+		NormalScale:       1,
+		OcclusionStrength: 1,
+		AlphaMode:         AlphaModeOpaque,
+		AlphaCutoff:       0.5,
+	}
+
+	twoSided, _ := GetMaterialBool(m, MatKeyTwoSided, TextureTypeNone, 0)
+	pbr.DoubleSided = twoSided
+
+	pbr.EmissiveFactor = matColor3Or(m, MatKeyColorEmissive, gglm.Vec3{})
+	pbr.EmissiveTexture = firstMatTexture(m, TextureTypeEmissive, TextureTypeEmissionColor)
+
+	if isPBRMaterial(m) {
+		readGLTFMaterial(m, pbr)
+	} else {
+		convertPhongMaterial(m, pbr)
+	}
+
+	//The getters return defaults if the scene is released while reading, so those results must not be returned
+	if err := m.cScene.checkReleased(); err != nil {
+		return nil, err
+	}
+
+	return pbr, nil
+}
+
+func isPBRMaterial(m *Material) bool {
+
+	if m.Property(MatKeyGLTFBaseColorFactor, TextureTypeNone, 0) != nil ||
+		m.Property(MatKeyGLTFMetallicFactor, TextureTypeNone, 0) != nil ||
+		m.Property(MatKeyGLTFRoughnessFactor, TextureTypeNone, 0) != nil {
+		return true
+	}
+
+	return GetMaterialTextureCount(m, TextureTypeBaseColor) > 0 ||
+		GetMaterialTextureCount(m, TextureTypeMetalness) > 0 ||
+		GetMaterialTextureCount(m, TextureTypeDiffuseRoughness) > 0
+}
+
+func readGLTFMaterial(m *Material, pbr *PBRMaterial) {
+
+	if c, err := GetMaterialColor(m, MatKeyGLTFBaseColorFactor, TextureTypeNone, 0); err == nil {
+		pbr.BaseColorFactor = *c
+	} else if c, err := GetMaterialColor(m, MatKeyColorDiffuse, TextureTypeNone, 0); err == nil {
+		pbr.BaseColorFactor = *c
+	}
+	pbr.BaseColorTexture = firstMatTexture(m, TextureTypeBaseColor, TextureTypeDiffuse)
+
+	pbr.MetallicFactor = matFloatOr(m, MatKeyGLTFMetallicFactor, TextureTypeNone, 0, pbr.MetallicFactor)
+	pbr.RoughnessFactor = matFloatOr(m, MatKeyGLTFRoughnessFactor, TextureTypeNone, 0, pbr.RoughnessFactor)
+
+	//The glTF importers store the metallic-roughness texture as the first unknown texture
+	if m.Property(MatKeyGLTFMetallicFactor, TextureTypeNone, 0) != nil || m.Property(MatKeyGLTFRoughnessFactor, TextureTypeNone, 0) != nil {
+		pbr.MetallicRoughnessTexture = firstMatTexture(m, TextureTypeUnknown)
+	}
+	pbr.MetallicTexture = firstMatTexture(m, TextureTypeMetalness)
+	pbr.RoughnessTexture = firstMatTexture(m, TextureTypeDiffuseRoughness)
+
+	pbr.NormalTexture = firstMatTexture(m, TextureTypeNormal, TextureTypeNormalCamera)
+	if pbr.NormalTexture != nil {
+		pbr.NormalScale = matFloatOr(m, MatKeyGLTFTextureScale, TextureTypeNormal, 0, pbr.NormalScale)
+	}
+
+	//glTF occlusion textures are imported as lightmaps
+	pbr.OcclusionTexture = firstMatTexture(m, TextureTypeAmbientOcclusion, TextureTypeLightmap)
+	if pbr.OcclusionTexture != nil {
+		pbr.OcclusionStrength = matFloatOr(m, MatKeyGLTFTextureStrength, TextureTypeLightmap, 0, pbr.OcclusionStrength)
+	}
+
+	if alphaMode, err := GetMaterialString(m, MatKeyGLTFAlphaMode, TextureTypeNone, 0); err == nil {
+		switch strings.ToUpper(alphaMode) {
+		case "MASK":
+			pbr.AlphaMode = AlphaModeMask
+		case "BLEND":
+			pbr.AlphaMode = AlphaModeBlend
+		}
+	}
+	pbr.AlphaCutoff = matFloatOr(m, MatKeyGLTFAlphaCutoff, TextureTypeNone, 0, pbr.AlphaCutoff)
+
+	pbr.Unlit = m.Property(MatKeyGLTFUnlit, TextureTypeNone, 0) != nil
+}
+
+func convertPhongMaterial(m *Material, pbr *PBRMaterial) {
+
+	pbr.FromPhong = true
+
+	if c, err := GetMaterialColor(m, MatKeyColorDiffuse, TextureTypeNone, 0); err == nil {
+		pbr.BaseColorFactor = *c
+	}
+	pbr.BaseColorTexture = firstMatTexture(m, TextureTypeDiffuse)
+
+	opacity := matFloatOr(m, MatKeyOpacity, TextureTypeNone, 0, 1)
+	pbr.BaseColorFactor.Data[3] = opacity
+	if opacity < 1 || GetMaterialTextureCount(m, TextureTypeOpacity) > 0 {
+		pbr.AlphaMode = AlphaModeBlend
+	}
+
+	pbr.MetallicFactor = 0
+	pbr.RoughnessFactor = shininessToRoughness(matFloatOr(m, MatKeyShininess, TextureTypeNone, 0, 0))
+
+	pbr.NormalTexture = firstMatTexture(m, TextureTypeNormal, TextureTypeHeight)
+	pbr.OcclusionTexture = firstMatTexture(m, TextureTypeAmbientOcclusion)
+}
+
+//shininessToRoughness maps a Blinn-Phong specular exponent to a roughness with sqrt(2/(shininess+2)).
+//Missing or invalid shininess values (<= 0 or NaN) give a roughness of 1
+func shininessToRoughness(shininess float32) float32 {
+
+	if !(shininess > 0) {
+		return 1
+	}
+
+	return float32(math.Sqrt(2 / (float64(shininess) + 2)))
+}
+
+//firstMatTexture returns the first texture (index 0) of the first texture type the material has, or nil
+func firstMatTexture(m *Material, texTypes ...TextureType) *GetMatTexInfo {
+
+	for _, tt := range texTypes {
+
+		if GetMaterialTextureCount(m, tt) == 0 {
+			continue
+		}
+
+		if texInfo, err := GetMaterialTexture(m, tt, 0); err == nil {
+			return texInfo
+		}
+	}
+
+	return nil
+}
+
+func matFloatOr(m *Material, key MatKey, texType TextureType, texIndex uint, defaultVal float32) float32 {
+
+	f, err := GetMaterialFloat(m, key, texType, texIndex)
+	if err != nil {
+		return defaultVal
+	}
+
+	return f
+}
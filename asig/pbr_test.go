@@ -0,0 +1,67 @@
+package asig
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestShininessToRoughness(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		shininess float32
+		want      float32
+	}{
+		{name: "missing", shininess: 0, want: 1},
+		{name: "negative", shininess: -5, want: 1},
+		{name: "nan", shininess: float32(math.NaN()), want: 1},
+		{name: "very rough", shininess: 0.0001, want: 0.99997},
+		{name: "two", shininess: 2, want: 0.70711},
+		{name: "blender default", shininess: 50, want: 0.19612},
+		{name: "obj max", shininess: 1000, want: 0.04468},
+		{name: "mirror", shininess: 1e9, want: 0.0000447},
+	}
+
+	for _, tt := range tests {
+
+		got := shininessToRoughness(tt.shininess)
+		if math.Abs(float64(got-tt.want)) > 1e-4 {
+			t.Errorf("%s: shininessToRoughness(%v) = %v, want %v", tt.name, tt.shininess, got, tt.want)
+		}
+	}
+}
+
+//Higher shininess is a sharper highlight, so roughness must only go down as shininess goes up
+func TestShininessToRoughnessIsDecreasing(t *testing.T) {
+
+	prev := shininessToRoughness(0)
+	for shininess := float32(0.5); shininess <= 2048; shininess *= 2 {
+
+		r := shininessToRoughness(shininess)
+		if r > prev || r <= 0 || r > 1 {
+			t.Fatalf("shininessToRoughness(%v) = %v, which isn't in (0, %v]", shininess, r, prev)
+		}
+
+		prev = r
+	}
+}
+
+//Material views of released scenes must fail instead of returning defaults
+func TestMaterialViewsOfReleasedScene(t *testing.T) {
+
+	m := &Material{cScene: &cSceneRef{}}
+
+	if pbr, err := PBRMaterialOf(m); !errors.Is(err, ErrSceneReleased) || pbr != nil {
+		t.Errorf("PBRMaterialOf() = (%v, %v), want (nil, %v)", pbr, err, ErrSceneReleased)
+	}
+
+	if pm, err := PhongMaterialOf(m); !errors.Is(err, ErrSceneReleased) || pm != nil {
+		t.Errorf("PhongMaterialOf() = (%v, %v), want (nil, %v)", pm, err, ErrSceneReleased)
+	}
+
+	//Materials created in Go have no scene at all
+	if _, err := PBRMaterialOf(&Material{}); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("PBRMaterialOf() of a material without a scene error = %v, want %v", err, ErrSceneReleased)
+	}
+}
@@ -0,0 +1,97 @@
+package asig
+
+import "github.com/bloeys/gglm/gglm"
+
+//PhongMaterial is a view of the traditional (Phong/Blinn) properties of a Material.
+//Properties missing from the material have the defaults documented by assimp
+type PhongMaterial struct {
+	Name string
+
+	AmbientColor  gglm.Vec3
+	DiffuseColor  gglm.Vec3
+	SpecularColor gglm.Vec3
+	EmissiveColor gglm.Vec3
+
+	//Exponent of the phong specular equation. Default 0
+	Shininess float32
+
+	//Scales the specular color. Default 1
+	ShininessStrength float32
+
+	//1 is fully opaque. Default 1
+	Opacity float32
+
+	//0 is fully opaque. Default 0
+	TransparencyFactor float32
+
+	//Index of refraction. Default 1
+	RefractionIndex float32
+
+	//Default 0
+	Reflectivity float32
+
+	//Whether backface culling must be disabled. Default false
+	TwoSided bool
+
+	//Whether the material must be rendered as wireframe. Default false
+	Wireframe bool
+
+	//Default ShadingModeGouraud
+	ShadingMode ShadingMode
+
+	//Default BlendModeDefault
+	BlendFunc BlendMode
+}
+
+//PhongMaterialOf returns a view of the traditional Phong/Blinn properties of the material.
+//ErrSceneReleased is returned if the scene of the material was released
+func PhongMaterialOf(m *Material) (*PhongMaterial, error) {
+
+	if err := m.cScene.checkReleased(); err != nil {
+		return nil, err
+	}
+
+	pm := &PhongMaterial{
+		AmbientColor:       matColor3Or(m, MatKeyColorAmbient, gglm.Vec3{}),
+		DiffuseColor:       matColor3Or(m, MatKeyColorDiffuse, gglm.Vec3{}),
+		SpecularColor:      matColor3Or(m, MatKeyColorSpecular, gglm.Vec3{}),
+		EmissiveColor:      matColor3Or(m, MatKeyColorEmissive, gglm.Vec3{}),
+		Shininess:          matFloatOr(m, MatKeyShininess, TextureTypeNone, 0, 0),
+		ShininessStrength:  matFloatOr(m, MatKeyShininessStrength, TextureTypeNone, 0, 1),
+		Opacity:            matFloatOr(m, MatKeyOpacity, TextureTypeNone, 0, 1),
+		TransparencyFactor: matFloatOr(m, MatKeyTransparencyFactor, TextureTypeNone, 0, 0),
+		RefractionIndex:    matFloatOr(m, MatKeyRefractI, TextureTypeNone, 0, 1),
+		Reflectivity:       matFloatOr(m, MatKeyReflectivity, TextureTypeNone, 0, 0),
+		ShadingMode:        ShadingModeGouraud,
+		BlendFunc:          BlendModeDefault,
+	}
+
+	pm.Name, _ = GetMaterialString(m, MatKeyName, TextureTypeNone, 0)
+	pm.TwoSided, _ = GetMaterialBool(m, MatKeyTwoSided, TextureTypeNone, 0)
+	pm.Wireframe, _ = GetMaterialBool(m, MatKeyEnableWireframe, TextureTypeNone, 0)
+
+	if sm, err := GetMaterialInt(m, MatKeyShadingModel, TextureTypeNone, 0); err == nil {
+		pm.ShadingMode = ShadingMode(sm)
+	}
+
+	if bm, err := GetMaterialInt(m, MatKeyBlendFunc, TextureTypeNone, 0); err == nil {
+		pm.BlendFunc = BlendMode(bm)
+	}
+
+	//The getters return defaults if the scene is released while reading, so those results must not be returned
+	if err := m.cScene.checkReleased(); err != nil {
+		return nil, err
+	}
+
+	return pm, nil
+}
+
+func matColor3Or(m *Material, key MatKey, defaultVal gglm.Vec3) gglm.Vec3 {
+
+	c, err := GetMaterialColor(m, key, TextureTypeNone, 0)
+	if err != nil {
+		return defaultVal
+	}
+
+	return gglm.Vec3{Data: [3]float32{c.R(), c.G(), c.B()}}
+}
@@ -0,0 +1,137 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include "wrap.c"
+
+struct aiMetadata* asig_cpp_copy_metadata(const struct aiMetadata* src);
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/** ApplyPostProcessing runs the post processing steps in flags on the imported scene, which is the same as importing with those flags.
+ * This allows importing a scene with few flags and deciding on the rest after inspecting it.
+ *
+ * Post processing happens in-place and can't be undone, so all the Go data of the scene (RootNode, Meshes, Materials, Textures etc.) is parsed again
+ * afterwards. Objects taken from the scene before the call keep their old Go data, and old materials return ErrSceneReleased from the getters.
+ * To derive several variants from one scene, post process a copy made with Scene.Clone for each variant:
+ *
+ *	variant, err := cached.Clone()
+ *	...
+ *	err = variant.ApplyPostProcessing(asig.PostProcessCalcTangentSpace)
+ *
+ * Only imported scenes can be post processed, not scenes built by Scene.Marshal (but their clones can).
+ * If post processing fails (which currently only PostProcessValidateDataStructure can cause), assimp releases the scene
+ * and the returned error wraps ErrValidationFailed.
+ */
+func (s *Scene) ApplyPostProcessing(flags PostProcess) error {
+
+	if err := libraryErr(); err != nil {
+		return err
+	}
+
+	//Users of the old C data (like old materials) are now stale, so they lose their scene and the parsed scene gets a new one
+	oldRef := s.cScene
+	if oldRef == nil {
+		return ErrSceneReleased
+	}
+
+	//Errors are collected from the log, so nothing else may run assimp code meanwhile
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	oldRef.lock.Lock()
+	defer oldRef.lock.Unlock()
+
+	if oldRef.cs == nil {
+		return ErrSceneReleased
+	}
+
+	if oldRef.marshalled {
+		return errors.New("asig error: can not apply post processing to a scene built by Scene.Marshal")
+	}
+
+	//aiGetErrorString isn't updated by post processing, so collect the errors from the log instead
+	errMsgs := []string{}
+	ls := attachLogStream(func(lm LogMessage) {
+		if lm.Severity >= LogSeverityError {
+			errMsgs = append(errMsgs, lm.Message)
+		}
+	})
+
+	cs := C.aiApplyPostProcessing(oldRef.cs, C.uint(flags))
+	ls.detach()
+
+	oldRef.cs = nil
+	for _, t := range s.Textures {
+		t.cTex = nil
+	}
+
+	//Assimp releases the scene when post processing fails
+	if cs == nil {
+
+		msg := strings.Join(errMsgs, "; ")
+		if msg == "" {
+			msg = "unknown error"
+		}
+
+		kind := importErrKind(msg)
+		if kind == nil {
+			kind = ErrValidationFailed
+		}
+
+		return fmt.Errorf("asig error: failed to apply post processing (flags %d), and the scene was released: %s: %w", flags, msg, kind)
+	}
+
+	*s = *parseScene(cs)
+	if oldRef.releaseOnGC {
+		s.cScene.setReleaseOnGC()
+	}
+
+	return nil
+}
+
+/** Clone returns an independent copy of the C scene, parsed into a new Scene. It must be closed like any imported scene.
+ *
+ * The copy is made by exporting the scene to assimp's lossless binary format (assbin) and importing it again,
+ * because assimp can only post process scenes it imported itself (a scene copied with aiCopyScene can't be post processed).
+ * This means the clone can be post processed even if the scene was built by Scene.Marshal, and that Go-side changes
+ * that weren't marshalled aren't part of the clone. Scene metadata isn't stored in the assbin format, so it is copied separately.
+ *
+ * Like exports, clones run in parallel with other asig calls unless a log function is set (see SetLogFunc).
+ */
+func (s *Scene) Clone() (*Scene, error) {
+
+	blobs, err := s.ExportToBlobs("assbin", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blobs) == 0 {
+		return nil, errors.New("asig error: can not clone scene because exporting it returned no data")
+	}
+
+	clone, _, err := ImportFromMemory(blobs[0].Data, "assbin", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cScene.rlock(); err != nil {
+		clone.Close()
+		return nil, err
+	}
+	defer s.cScene.runlock()
+
+	//The clone isn't shared yet, so its C scene can be changed without locking it
+	if s.cScene.cs.mMetaData != nil && clone.cScene.cs.mMetaData == nil {
+		clone.cScene.cs.mMetaData = C.asig_cpp_copy_metadata(s.cScene.cs.mMetaData)
+		clone.Metadata = parseMetadata(clone.cScene.cs.mMetaData)
+	}
+
+	return clone, nil
+}
@@ -0,0 +1,347 @@
+package asig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrTextureNotFound = errors.New("texture not found")
+)
+
+//ResolvedTexture is the image a material texture path points to. Exactly one of Embedded or File is set
+type ResolvedTexture struct {
+	//The path as stored in the material
+	Path string
+
+	//Set if the texture is embedded in the model file
+	Embedded *EmbeddedTexture
+
+	//Set if the texture is an external file. FilePath is the path File was opened with.
+	//The file must be closed by the caller, for example by calling ResolvedTexture.Close
+	File     *os.File
+	FilePath string
+}
+
+//Close closes the external file, if any. It is safe to call on embedded textures
+func (rt *ResolvedTexture) Close() error {
+
+	if rt.File == nil {
+		return nil
+	}
+
+	return rt.File.Close()
+}
+
+/** ResolveTexture finds the image a texture path (e.g. GetMatTexInfo.Path) points to.
+ *
+ * The path is resolved in this order:
+ *   1. '*N' paths are an index into Scene.Textures
+ *   2. Paths matching the Filename of an embedded texture return that texture
+ *   3. Otherwise the path is an external file. Backslashes are treated as separators and relative paths are looked up
+ *      in modelDir then each of searchPaths. If that fails, the file name alone is looked up in the same directories.
+ *      If the exact path doesn't exist, a case-insensitive match is tried
+ *
+ * If nothing is found the returned error wraps ErrTextureNotFound.
+ */
+func (s *Scene) ResolveTexture(texPath, modelDir string, searchPaths ...string) (*ResolvedTexture, error) {
+
+	if embTex, err := s.embeddedTexture(texPath); embTex != nil || err != nil {
+		if err != nil {
+			return nil, err
+		}
+
+		return &ResolvedTexture{Path: texPath, Embedded: embTex}, nil
+	}
+
+	filePath, ok := resolveTexturePath(texPath, modelDir, searchPaths)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTextureNotFound, texPath)
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolvedTexture{Path: texPath, File: f, FilePath: filePath}, nil
+}
+
+//MissingTextures returns the paths of all material textures that ResolveTexture can't find, without duplicates.
+//ErrSceneReleased is returned if the scene was released, as the texture paths are read from the C materials
+func (s *Scene) MissingTextures(modelDir string, searchPaths ...string) ([]string, error) {
+
+	if err := s.cScene.checkReleased(); err != nil {
+		return nil, err
+	}
+
+	missing := []string{}
+	seen := map[string]struct{}{}
+
+	for _, m := range s.Materials {
+		for texType := TextureTypeNone; texType <= TextureTypeUnknown; texType++ {
+
+			texCount := GetMaterialTextureCount(m, texType)
+			for i := 0; i < texCount; i++ {
+
+				texInfo, err := GetMaterialTexture(m, texType, uint(i))
+				if errors.Is(err, ErrSceneReleased) {
+					return nil, err
+				}
+
+				if err != nil {
+					continue
+				}
+
+				if _, ok := seen[texInfo.Path]; ok {
+					continue
+				}
+				seen[texInfo.Path] = struct{}{}
+
+				if embTex, _ := s.embeddedTexture(texInfo.Path); embTex != nil {
+					continue
+				}
+
+				if _, ok := resolveTexturePath(texInfo.Path, modelDir, searchPaths); !ok {
+					missing = append(missing, texInfo.Path)
+				}
+			}
+		}
+	}
+
+	//Texture counts are 0 once the scene is released, so a release while reading would hide textures
+	if err := s.cScene.checkReleased(); err != nil {
+		return nil, err
+	}
+
+	return missing, nil
+}
+
+//embeddedTexture returns the embedded texture texPath refers to, or nil if it isn't embedded.
+//An error is only returned for '*N' paths with an invalid index
+func (s *Scene) embeddedTexture(texPath string) (*EmbeddedTexture, error) {
+
+	if strings.HasPrefix(texPath, "*") {
+
+		index, err := strconv.Atoi(texPath[1:])
+		if err != nil || index < 0 || index >= len(s.Textures) {
+			return nil, fmt.Errorf("%w: embedded texture index out of range: %s", ErrTextureNotFound, texPath)
+		}
+
+		return s.Textures[index], nil
+	}
+
+	normPath := normalizeTexPath(texPath)
+	for _, t := range s.Textures {
+		if t.Filename != "" && normalizeTexPath(t.Filename) == normPath {
+			return t, nil
+		}
+	}
+
+	//Assimp matches embedded textures by file name only, so do the same as a fallback
+	baseName := filepath.Base(normPath)
+	for _, t := range s.Textures {
+		if t.Filename != "" && strings.EqualFold(filepath.Base(normalizeTexPath(t.Filename)), baseName) {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//resolveTexturePath returns the path of the external file texPath refers to
+func resolveTexturePath(texPath, modelDir string, searchPaths []string) (string, bool) {
+
+	normPath := normalizeTexPath(texPath)
+	if normPath == "" || normPath == "." {
+		return "", false
+	}
+
+	if filepath.IsAbs(normPath) {
+		if p, ok := findFile(normPath); ok {
+			return p, true
+		}
+	}
+
+	dirs := make([]string, 0, len(searchPaths)+1)
+	dirs = append(dirs, modelDir)
+	dirs = append(dirs, searchPaths...)
+
+	//Absolute paths from other machines (e.g. 'C:/textures/a.png' on linux) can only be found by their file name
+	if !filepath.IsAbs(normPath) && !hasWindowsVolume(normPath) {
+		for _, dir := range dirs {
+			if p, ok := findFile(filepath.Join(dir, normPath)); ok {
+				return p, true
+			}
+		}
+	}
+
+	baseName := filepath.Base(normPath)
+	for _, dir := range dirs {
+		if p, ok := findFile(filepath.Join(dir, baseName)); ok {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+//findFile returns p if it is an existing file, otherwise it looks for a file matching p case-insensitively
+func findFile(p string) (string, bool) {
+
+	if fi, err := os.Stat(p); err == nil {
+		return p, !fi.IsDir()
+	}
+
+	dir, name := filepath.Split(filepath.Clean(p))
+	if name == "" {
+		return "", false
+	}
+
+	//The directory might have a case mismatch too
+	resolvedDir, ok := findDir(filepath.Clean(dir))
+	if !ok {
+		return "", false
+	}
+
+	entries, err := os.ReadDir(resolvedDir)
+	if err != nil {
+		return "", false
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(e.Name(), name) {
+			return filepath.Join(resolvedDir, e.Name()), true
+		}
+	}
+
+	return "", false
+}
+
+//findDir is like findFile but for directories
+func findDir(p string) (string, bool) {
+
+	if fi, err := os.Stat(p); err == nil {
+		return p, fi.IsDir()
+	}
+
+	parent, name := filepath.Split(filepath.Clean(p))
+	parent = filepath.Clean(parent)
+	if name == "" || parent == filepath.Clean(p) {
+		return "", false
+	}
+
+	resolvedParent, ok := findDir(parent)
+	if !ok {
+		return "", false
+	}
+
+	entries, err := os.ReadDir(resolvedParent)
+	if err != nil {
+		return "", false
+	}
+
+	for _, e := range entries {
+		if e.IsDir() && strings.EqualFold(e.Name(), name) {
+			return filepath.Join(resolvedParent, e.Name()), true
+		}
+	}
+
+	return "", false
+}
+
+//normalizeTexPath converts backslashes to the OS separator and cleans the path
+func normalizeTexPath(texPath string) string {
+
+	texPath = strings.TrimSpace(texPath)
+	if texPath == "" {
+		return ""
+	}
+
+	return filepath.Clean(filepath.FromSlash(strings.ReplaceAll(texPath, "\\", "/")))
+}
+
+func hasWindowsVolume(p string) bool {
+	return len(p) >= 2 && p[1] == ':' && ((p[0] >= 'a' && p[0] <= 'z') || (p[0] >= 'A' && p[0] <= 'Z'))
+}
+
+//ImageDecoder decodes the data of a compressed embedded texture
+type ImageDecoder func(data []byte) (image.Image, error)
+
+var (
+	imageDecodersLock sync.RWMutex
+	imageDecoders     = map[string]ImageDecoder{}
+)
+
+//RegisterImageDecoder sets the decoder used by EmbeddedTexture.Image for compressed textures with the given
+//format hint (e.g. 'dds' or 'tga'). Format hints are case-insensitive. Registering a decoder for a format hint
+//replaces the previous one, and passing a nil decoder removes it.
+//
+//Formats without a registered decoder are decoded with image.Decode, which only knows the formats registered with image.RegisterFormat.
+//asig doesn't register any, so applications must import the decoders they need, for example:
+//
+//	import _ "image/png"
+//	import _ "image/jpeg"
+func RegisterImageDecoder(formatHint string, decoder ImageDecoder) {
+
+	imageDecodersLock.Lock()
+	defer imageDecodersLock.Unlock()
+
+	formatHint = strings.ToLower(formatHint)
+	if decoder == nil {
+		delete(imageDecoders, formatHint)
+		return
+	}
+
+	imageDecoders[formatHint] = decoder
+}
+
+//Image decodes the texture. Uncompressed textures are returned as *image.NRGBA, while compressed
+//textures are decoded using the decoder registered for their format hint, or image.Decode (see RegisterImageDecoder)
+func (t *EmbeddedTexture) Image() (image.Image, error) {
+
+	if t.IsCompressed {
+		return decodeCompressedTexture(t.FormatHint, t.Data)
+	}
+
+	w, h := int(t.Width), int(t.Height)
+	if len(t.Data) < w*h*4 {
+		return nil, fmt.Errorf("embedded texture has %d bytes of data but its size needs %d", len(t.Data), w*h*4)
+	}
+
+	//Texels are stored as b,g,r,a
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < w*h*4; i += 4 {
+		img.Pix[i] = t.Data[i+2]
+		img.Pix[i+1] = t.Data[i+1]
+		img.Pix[i+2] = t.Data[i]
+		img.Pix[i+3] = t.Data[i+3]
+	}
+
+	return img, nil
+}
+
+func decodeCompressedTexture(formatHint string, data []byte) (image.Image, error) {
+
+	imageDecodersLock.RLock()
+	decoder := imageDecoders[strings.ToLower(formatHint)]
+	imageDecodersLock.RUnlock()
+
+	if decoder != nil {
+		return decoder(data)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode embedded texture with format hint '%s': %w", formatHint, err)
+	}
+
+	return img, nil
+}
@@ -0,0 +1,242 @@
+package asig
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveTexturePath(t *testing.T) {
+
+	root := t.TempDir()
+	modelDir := filepath.Join(root, "model")
+	searchDir := filepath.Join(root, "shared")
+
+	files := []string{
+		filepath.Join(modelDir, "diffuse.png"),
+		filepath.Join(modelDir, "Textures", "Normal.PNG"),
+		filepath.Join(searchDir, "rough.jpg"),
+		filepath.Join(root, "absolute.tga"),
+	}
+
+	for _, f := range files {
+
+		if err := os.MkdirAll(filepath.Dir(f), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(f, []byte("img"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	//A directory with a texture-like name must not be returned
+	if err := os.MkdirAll(filepath.Join(modelDir, "folder.png"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		texPath string
+		want    string
+		wantOk  bool
+	}{
+		{name: "relative", texPath: "diffuse.png", want: files[0], wantOk: true},
+		{name: "dot relative", texPath: "./diffuse.png", want: files[0], wantOk: true},
+		{name: "subdir", texPath: "Textures/Normal.PNG", want: files[1], wantOk: true},
+		{name: "backslashes", texPath: "Textures\\Normal.PNG", want: files[1], wantOk: true},
+		{name: "case mismatch", texPath: "textures/normal.png", want: files[1], wantOk: true},
+		{name: "search path", texPath: "rough.jpg", want: files[2], wantOk: true},
+		{name: "file name fallback", texPath: "old/export/dir/diffuse.png", want: files[0], wantOk: true},
+		{name: "windows absolute", texPath: "C:\\Users\\artist\\rough.jpg", want: files[2], wantOk: true},
+		{name: "absolute", texPath: files[3], want: files[3], wantOk: true},
+		{name: "surrounding spaces", texPath: " diffuse.png ", want: files[0], wantOk: true},
+		{name: "missing", texPath: "missing.png", wantOk: false},
+		{name: "empty", texPath: "", wantOk: false},
+		{name: "dot", texPath: ".", wantOk: false},
+		{name: "directory", texPath: "folder.png", wantOk: false},
+	}
+
+	for _, tt := range tests {
+
+		got, ok := resolveTexturePath(tt.texPath, modelDir, []string{searchDir})
+		if ok != tt.wantOk {
+			t.Errorf("%s: resolveTexturePath(%q) ok = %v, want %v (path %q)", tt.name, tt.texPath, ok, tt.wantOk, got)
+			continue
+		}
+
+		if ok && !sameFile(t, got, tt.want) {
+			t.Errorf("%s: resolveTexturePath(%q) = %q, want %q", tt.name, tt.texPath, got, tt.want)
+		}
+	}
+}
+
+//sameFile compares files instead of paths, as on case-insensitive file systems the exact path is found first
+func sameFile(t *testing.T, p1, p2 string) bool {
+
+	fi1, err := os.Stat(p1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fi2, err := os.Stat(p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return os.SameFile(fi1, fi2)
+}
+
+func TestHasWindowsVolume(t *testing.T) {
+
+	tests := []struct {
+		p    string
+		want bool
+	}{
+		{p: "C:/textures/a.png", want: true},
+		{p: "d:\\a.png", want: true},
+		{p: "C:", want: true},
+		{p: "textures/a.png", want: false},
+		{p: "/textures/a.png", want: false},
+		{p: "1:/a.png", want: false},
+		{p: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := hasWindowsVolume(tt.p); got != tt.want {
+			t.Errorf("hasWindowsVolume(%q) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
+
+//A released scene has no texture paths to check, which must not be reported as nothing missing
+func TestMissingTexturesOfReleasedScene(t *testing.T) {
+
+	s := &Scene{cScene: &cSceneRef{}, Materials: []*Material{{cScene: &cSceneRef{}}}}
+	if missing, err := s.MissingTextures(t.TempDir()); !errors.Is(err, ErrSceneReleased) || missing != nil {
+		t.Errorf("MissingTextures() = (%v, %v), want (nil, %v)", missing, err, ErrSceneReleased)
+	}
+
+	//Without materials there is nothing to read, but the scene is still released
+	if _, err := (&Scene{cScene: &cSceneRef{}}).MissingTextures(t.TempDir()); !errors.Is(err, ErrSceneReleased) {
+		t.Errorf("MissingTextures() of a scene without materials error = %v, want %v", err, ErrSceneReleased)
+	}
+}
+
+func TestEmbeddedTextureImageUncompressed(t *testing.T) {
+
+	//Texels are stored as b,g,r,a
+	tex := &EmbeddedTexture{
+		Width:  2,
+		Height: 2,
+		Data: []byte{
+			0, 0, 255, 255, 0, 255, 0, 128,
+			255, 0, 0, 0, 10, 20, 30, 40,
+		},
+	}
+
+	img, err := tex.Image()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nrgba, ok := img.(*image.NRGBA)
+	if !ok {
+		t.Fatalf("Image() returned %T, want *image.NRGBA", img)
+	}
+
+	tests := []struct {
+		x, y int
+		want color.NRGBA
+	}{
+		{x: 0, y: 0, want: color.NRGBA{R: 255, G: 0, B: 0, A: 255}},
+		{x: 1, y: 0, want: color.NRGBA{R: 0, G: 255, B: 0, A: 128}},
+		{x: 0, y: 1, want: color.NRGBA{R: 0, G: 0, B: 255, A: 0}},
+		{x: 1, y: 1, want: color.NRGBA{R: 30, G: 20, B: 10, A: 40}},
+	}
+
+	for _, tt := range tests {
+		if got := nrgba.NRGBAAt(tt.x, tt.y); got != tt.want {
+			t.Errorf("pixel (%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestEmbeddedTextureImageShortData(t *testing.T) {
+
+	tests := []struct {
+		name string
+		tex  *EmbeddedTexture
+	}{
+		{name: "empty", tex: &EmbeddedTexture{Width: 1, Height: 1}},
+		{name: "one texel short", tex: &EmbeddedTexture{Width: 2, Height: 1, Data: make([]byte, 4)}},
+		{name: "one byte short", tex: &EmbeddedTexture{Width: 2, Height: 2, Data: make([]byte, 15)}},
+	}
+
+	for _, tt := range tests {
+		if _, err := tt.tex.Image(); err == nil {
+			t.Errorf("%s: Image() succeeded with %d bytes for a %dx%d texture", tt.name, len(tt.tex.Data), tt.tex.Width, tt.tex.Height)
+		}
+	}
+}
+
+func TestEmbeddedTextureImageCompressed(t *testing.T) {
+
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	src.SetNRGBA(2, 1, color.NRGBA{R: 1, G: 2, B: 3, A: 255})
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, src); err != nil {
+		t.Fatal(err)
+	}
+
+	errCustom := errors.New("custom decoder")
+	RegisterImageDecoder("TestFmt", func(data []byte) (image.Image, error) {
+		return nil, errCustom
+	})
+	defer RegisterImageDecoder("testfmt", nil)
+
+	tests := []struct {
+		name       string
+		formatHint string
+		data       []byte
+		wantErr    error
+		wantBounds image.Rectangle
+	}{
+		{name: "png", formatHint: "png", data: buf.Bytes(), wantBounds: src.Bounds()},
+		{name: "png with wrong hint", formatHint: "jpg", data: buf.Bytes(), wantBounds: src.Bounds()},
+		{name: "custom decoder", formatHint: "testfmt", data: buf.Bytes(), wantErr: errCustom},
+		{name: "custom decoder case-insensitive", formatHint: "TESTFMT", data: buf.Bytes(), wantErr: errCustom},
+		{name: "garbage", formatHint: "png", data: []byte("not an image"), wantErr: image.ErrFormat},
+	}
+
+	for _, tt := range tests {
+
+		tex := &EmbeddedTexture{Width: uint(len(tt.data)), FormatHint: tt.formatHint, Data: tt.data, IsCompressed: true}
+		img, err := tex.Image()
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: Image() error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Image() failed: %v", tt.name, err)
+			continue
+		}
+
+		if img.Bounds() != tt.wantBounds {
+			t.Errorf("%s: Image() bounds = %v, want %v", tt.name, img.Bounds(), tt.wantBounds)
+		}
+
+		if r, g, b, _ := img.At(2, 1).RGBA(); r>>8 != 1 || g>>8 != 2 || b>>8 != 3 {
+			t.Errorf("%s: pixel (2, 1) = %v, want (1, 2, 3)", tt.name, img.At(2, 1))
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package asig
+
+/*
+#cgo CFLAGS: -I .
+
+#include "wrap.c"
+*/
+import "C"
+import "fmt"
+
+//The assimp version of the headers in asig/assimp. The C structs used by asig are laid out
+//according to these headers, so the linked library must have the same major and minor version.
+const (
+	HeaderVersionMajor = 5
+	HeaderVersionMinor = 0
+)
+
+//LibVersion returns the version of the assimp library asig is linked against
+func LibVersion() (major, minor, revision uint) {
+	return uint(C.aiGetVersionMajor()), uint(C.aiGetVersionMinor()), uint(C.aiGetVersionRevision())
+}
+
+func checkLibVersion() error {
+
+	major, minor, revision := LibVersion()
+	if major == HeaderVersionMajor && minor == HeaderVersionMinor {
+		return nil
+	}
+
+	return fmt.Errorf("%w: linked assimp version %v.%v (revision %x) does not match the asig headers version %v.%v. Use an assimp %v.%v library",
+		ErrLibraryVersionMismatch, major, minor, revision, HeaderVersionMajor, HeaderVersionMinor, HeaderVersionMajor, HeaderVersionMinor)
+}
@@ -1,3 +1,7 @@
 #include <assimp/cimport.h>        // Plain-C interface
 #include <assimp/scene.h>          // Output data structure
 #include <assimp/postprocess.h>
+#include <assimp/version.h>
+#include <assimp/cfileio.h>
+#include <assimp/cexport.h>
+#include <assimp/importerdesc.h>
@@ -0,0 +1,386 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/bloeys/assimp-go/asig"
+	"github.com/bloeys/gglm/gglm"
+)
+
+//sceneInfo is everything asig-info prints. The text output and the JSON output are both made from it
+type sceneInfo struct {
+	File       string          `json:"file"`
+	Flags      string          `json:"flags"`
+	Metadata   []metadataInfo  `json:"metadata"`
+	RootNode   *nodeInfo       `json:"rootNode"`
+	Meshes     []*meshInfo     `json:"meshes"`
+	Materials  []*materialInfo `json:"materials"`
+	Textures   []*textureInfo  `json:"textures"`
+	Animations int             `json:"animations"`
+	Lights     int             `json:"lights"`
+	Cameras    int             `json:"cameras"`
+}
+
+type metadataInfo struct {
+	Key   string      `json:"key"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type nodeInfo struct {
+	Name string `json:"name"`
+
+	//Row-major, so it reads naturally in JSON
+	Transform [4][4]jsonFloat32 `json:"transform"`
+	Meshes    []uint            `json:"meshes"`
+	Metadata  []metadataInfo    `json:"metadata,omitempty"`
+	Children  []*nodeInfo       `json:"children"`
+}
+
+type meshInfo struct {
+	Name           string         `json:"name"`
+	PrimitiveTypes string         `json:"primitiveTypes"`
+	Vertices       int            `json:"vertices"`
+	Faces          int            `json:"faces"`
+	Bones          int            `json:"bones"`
+	AnimMeshes     int            `json:"animMeshes"`
+	Channels       []string       `json:"channels"`
+	AABBMin        [3]jsonFloat32 `json:"aabbMin"`
+	AABBMax        [3]jsonFloat32 `json:"aabbMax"`
+	MaterialIndex  uint           `json:"materialIndex"`
+	MaterialName   string         `json:"materialName"`
+}
+
+type materialInfo struct {
+	Name       string          `json:"name"`
+	Properties []*propertyInfo `json:"properties"`
+}
+
+type propertyInfo struct {
+	Key string `json:"key"`
+
+	//Semantic is empty and Index is 0 for properties that aren't about a texture
+	Semantic string `json:"semantic,omitempty"`
+	Index    uint   `json:"index"`
+
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+//jsonFloat32 and jsonFloat64 are floats that can always be encoded as JSON. JSON has no NaN or infinity,
+//so those are encoded as the strings "NaN", "+Inf" and "-Inf". Broken or unusual models can contain them
+type jsonFloat32 float32
+type jsonFloat64 float64
+
+func (f jsonFloat32) MarshalJSON() ([]byte, error) {
+	return marshalJSONFloat(float64(f), 32)
+}
+
+func (f jsonFloat64) MarshalJSON() ([]byte, error) {
+	return marshalJSONFloat(float64(f), 64)
+}
+
+func marshalJSONFloat(f float64, bitSize int) ([]byte, error) {
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return []byte(strconv.Quote(strconv.FormatFloat(f, 'g', -1, bitSize))), nil
+	}
+
+	if bitSize == 32 {
+		return json.Marshal(float32(f))
+	}
+
+	return json.Marshal(f)
+}
+
+func jsonVec3(v gglm.Vec3) [3]jsonFloat32 {
+	return [3]jsonFloat32{jsonFloat32(v.Data[0]), jsonFloat32(v.Data[1]), jsonFloat32(v.Data[2])}
+}
+
+type textureInfo struct {
+	Filename   string `json:"filename"`
+	FormatHint string `json:"formatHint"`
+	Compressed bool   `json:"compressed"`
+
+	//Width is the data size in bytes for compressed textures, and Height is 0
+	Width  uint `json:"width"`
+	Height uint `json:"height"`
+	Size   int  `json:"size"`
+}
+
+func newSceneInfo(file string, s *asig.Scene) *sceneInfo {
+
+	info := &sceneInfo{
+		File:       file,
+		Flags:      s.Flags.String(),
+		Metadata:   newMetadataInfo(s.Metadata),
+		Meshes:     make([]*meshInfo, len(s.Meshes)),
+		Materials:  make([]*materialInfo, len(s.Materials)),
+		Textures:   make([]*textureInfo, len(s.Textures)),
+		Animations: len(s.Animations),
+		Lights:     len(s.Lights),
+		Cameras:    len(s.Cameras),
+	}
+
+	if s.RootNode != nil {
+		info.RootNode = newNodeInfo(s.RootNode)
+	}
+
+	for i, m := range s.Materials {
+		info.Materials[i] = newMaterialInfo(m)
+	}
+
+	for i, m := range s.Meshes {
+
+		info.Meshes[i] = newMeshInfo(m)
+		if m.MaterialIndex < uint(len(info.Materials)) {
+			info.Meshes[i].MaterialName = info.Materials[m.MaterialIndex].Name
+		}
+	}
+
+	for i, t := range s.Textures {
+		info.Textures[i] = &textureInfo{
+			Filename:   t.Filename,
+			FormatHint: t.FormatHint,
+			Compressed: t.IsCompressed,
+			Width:      t.Width,
+			Height:     t.Height,
+			Size:       len(t.Data),
+		}
+	}
+
+	return info
+}
+
+func newMetadataInfo(meta map[string]asig.Metadata) []metadataInfo {
+
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	info := make([]metadataInfo, len(keys))
+	for i, k := range keys {
+
+		md := meta[k]
+		info[i] = metadataInfo{Key: k, Type: metadataTypeName(md.Type), Value: md.Value}
+		switch v := md.Value.(type) {
+		case float32:
+			info[i].Value = jsonFloat32(v)
+		case float64:
+			info[i].Value = jsonFloat64(v)
+		case gglm.Vec3:
+			info[i].Value = jsonVec3(v)
+		}
+	}
+
+	return info
+}
+
+func metadataTypeName(mt asig.MetadataType) string {
+
+	switch mt {
+	case asig.MetadataTypeBool:
+		return "Bool"
+	case asig.MetadataTypeInt32:
+		return "Int32"
+	case asig.MetadataTypeUint64:
+		return "Uint64"
+	case asig.MetadataTypeFloat32:
+		return "Float32"
+	case asig.MetadataTypeFloat64:
+		return "Float64"
+	case asig.MetadataTypeString:
+		return "String"
+	case asig.MetadataTypeVec3:
+		return "Vec3"
+	default:
+		return "Unknown"
+	}
+}
+
+func newNodeInfo(n *asig.Node) *nodeInfo {
+
+	info := &nodeInfo{
+		Name:     n.Name,
+		Meshes:   n.MeshIndicies,
+		Metadata: newMetadataInfo(n.Metadata),
+		Children: make([]*nodeInfo, len(n.Children)),
+	}
+
+	if info.Meshes == nil {
+		info.Meshes = []uint{}
+	}
+
+	transform := n.Transformation
+	if transform == nil {
+		transform = gglm.NewMat4Id()
+	}
+
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			info.Transform[row][col] = jsonFloat32(transform.Get(row, col))
+		}
+	}
+
+	for i, c := range n.Children {
+		info.Children[i] = newNodeInfo(c)
+	}
+
+	return info
+}
+
+func newMeshInfo(m *asig.Mesh) *meshInfo {
+
+	info := &meshInfo{
+		Name:           m.Name,
+		PrimitiveTypes: m.PrimitiveTypes.String(),
+		Vertices:       len(m.Vertices),
+		Faces:          len(m.Faces),
+		Bones:          len(m.Bones),
+		AnimMeshes:     len(m.AnimMeshes),
+		Channels:       meshChannels(m),
+		AABBMin:        jsonVec3(m.AABB.Min),
+		AABBMax:        jsonVec3(m.AABB.Max),
+		MaterialIndex:  m.MaterialIndex,
+	}
+
+	return info
+}
+
+//meshChannels returns the names of the vertex channels the mesh has, like 'normals', 'colors0' or 'uv0(2)',
+//where the number in brackets is the number of components of the UV channel
+func meshChannels(m *asig.Mesh) []string {
+
+	channels := []string{}
+	if len(m.Vertices) > 0 {
+		channels = append(channels, "positions")
+	}
+
+	if len(m.Normals) > 0 {
+		channels = append(channels, "normals")
+	}
+
+	if len(m.Tangents) > 0 {
+		channels = append(channels, "tangents")
+	}
+
+	if len(m.BitTangents) > 0 {
+		channels = append(channels, "bitangents")
+	}
+
+	for i := 0; i < asig.MaxColorSets; i++ {
+		if len(m.ColorSets[i]) > 0 {
+			channels = append(channels, "colors"+strconv.Itoa(i))
+		}
+	}
+
+	for i := 0; i < asig.MaxTexCoords; i++ {
+		if len(m.TexCoords[i]) > 0 {
+			channels = append(channels, "uv"+strconv.Itoa(i)+"("+strconv.Itoa(int(m.TexCoordChannelCount[i]))+")")
+		}
+	}
+
+	return channels
+}
+
+func newMaterialInfo(m *asig.Material) *materialInfo {
+
+	info := &materialInfo{
+		Properties: make([]*propertyInfo, len(m.Properties)),
+	}
+
+	if name, err := asig.GetMaterialString(m, asig.MatKeyName, asig.TextureTypeNone, 0); err == nil {
+		info.Name = name
+	}
+
+	for i, p := range m.Properties {
+
+		info.Properties[i] = &propertyInfo{
+			Key:   p.Name,
+			Index: p.Index,
+			Type:  p.TypeInfo.String(),
+			Value: decodeProperty(p),
+		}
+
+		if p.Semantic != asig.TextureTypeNone {
+			info.Properties[i].Semantic = p.Semantic.String()
+		}
+	}
+
+	return info
+}
+
+//decodeProperty returns the value of a material property from its raw data. Numbers are returned as a single value if
+//there is only one, otherwise as a slice. Buffers are returned as a hex string.
+//Property data is in the byte order of the machine, which is little endian on all platforms asig supports
+func decodeProperty(p *asig.MaterialProperty) interface{} {
+
+	data := p.Data
+	switch p.TypeInfo {
+
+	case asig.MatPropTypeInfoFloat32:
+
+		vals := make([]jsonFloat32, len(data)/4)
+		for i := range vals {
+			vals[i] = jsonFloat32(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+		return singleOrSlice(vals, len(vals))
+
+	case asig.MatPropTypeInfoFloat64:
+
+		vals := make([]jsonFloat64, len(data)/8)
+		for i := range vals {
+			vals[i] = jsonFloat64(math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+		return singleOrSlice(vals, len(vals))
+
+	case asig.MatPropTypeInfoInt32:
+
+		vals := make([]int32, len(data)/4)
+		for i := range vals {
+			vals[i] = int32(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+		return singleOrSlice(vals, len(vals))
+
+	case asig.MatPropTypeInfoString:
+
+		//Strings are stored as a 32-bit length, followed by the characters and a null terminator
+		if len(data) < 4 {
+			return ""
+		}
+
+		strLen := int(binary.LittleEndian.Uint32(data))
+		if strLen > len(data)-4 {
+			strLen = len(data) - 4
+		}
+		return string(data[4 : 4+strLen])
+
+	default:
+		return hex.EncodeToString(data)
+	}
+}
+
+func singleOrSlice(vals interface{}, count int) interface{} {
+
+	if count != 1 {
+		return vals
+	}
+
+	switch v := vals.(type) {
+	case []jsonFloat32:
+		return v[0]
+	case []jsonFloat64:
+		return v[0]
+	case []int32:
+		return v[0]
+	default:
+		return vals
+	}
+}
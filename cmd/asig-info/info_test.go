@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/bloeys/assimp-go/asig"
+)
+
+func float32Data(vals ...float32) []byte {
+
+	data := make([]byte, len(vals)*4)
+	for i, v := range vals {
+		binary.LittleEndian.PutUint32(data[i*4:], math.Float32bits(v))
+	}
+
+	return data
+}
+
+func float64Data(vals ...float64) []byte {
+
+	data := make([]byte, len(vals)*8)
+	for i, v := range vals {
+		binary.LittleEndian.PutUint64(data[i*8:], math.Float64bits(v))
+	}
+
+	return data
+}
+
+func int32Data(vals ...int32) []byte {
+
+	data := make([]byte, len(vals)*4)
+	for i, v := range vals {
+		binary.LittleEndian.PutUint32(data[i*4:], uint32(v))
+	}
+
+	return data
+}
+
+func TestDecodeProperty(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		typeInfo asig.MatPropertyTypeInfo
+		data     []byte
+		want     interface{}
+		wantJSON string
+	}{
+		{name: "float32", typeInfo: asig.MatPropTypeInfoFloat32, data: float32Data(0.5), want: jsonFloat32(0.5), wantJSON: `0.5`},
+		{name: "float32 color", typeInfo: asig.MatPropTypeInfoFloat32, data: float32Data(1, 0.25, 0, 1), want: []jsonFloat32{1, 0.25, 0, 1}, wantJSON: `[1,0.25,0,1]`},
+		{name: "float32 no full value", typeInfo: asig.MatPropTypeInfoFloat32, data: []byte{1, 2, 3}, want: []jsonFloat32{}, wantJSON: `[]`},
+		{name: "float32 nan", typeInfo: asig.MatPropTypeInfoFloat32, data: float32Data(float32(math.NaN())), wantJSON: `"NaN"`},
+		{name: "float32 inf", typeInfo: asig.MatPropTypeInfoFloat32, data: float32Data(float32(math.Inf(1)), float32(math.Inf(-1)), 0.1), want: []jsonFloat32{jsonFloat32(math.Inf(1)), jsonFloat32(math.Inf(-1)), 0.1}, wantJSON: `["+Inf","-Inf",0.1]`},
+		{name: "float64", typeInfo: asig.MatPropTypeInfoFloat64, data: float64Data(0.1), want: jsonFloat64(0.1), wantJSON: `0.1`},
+		{name: "float64 inf", typeInfo: asig.MatPropTypeInfoFloat64, data: float64Data(math.Inf(-1), 2), want: []jsonFloat64{jsonFloat64(math.Inf(-1)), 2}, wantJSON: `["-Inf",2]`},
+		{name: "int32", typeInfo: asig.MatPropTypeInfoInt32, data: int32Data(-3), want: int32(-3), wantJSON: `-3`},
+		{name: "int32 slice", typeInfo: asig.MatPropTypeInfoInt32, data: int32Data(1, 2), want: []int32{1, 2}, wantJSON: `[1,2]`},
+		{name: "string", typeInfo: asig.MatPropTypeInfoString, data: append(int32Data(3), 'r', 'e', 'd', 0), want: "red", wantJSON: `"red"`},
+		{name: "string too long", typeInfo: asig.MatPropTypeInfoString, data: append(int32Data(100), 'a', 'b'), want: "ab", wantJSON: `"ab"`},
+		{name: "string no length", typeInfo: asig.MatPropTypeInfoString, data: []byte{1, 0}, want: "", wantJSON: `""`},
+		{name: "buffer", typeInfo: asig.MatPropTypeInfoBuffer, data: []byte{0xde, 0xad}, want: "dead", wantJSON: `"dead"`},
+	}
+
+	for _, tt := range tests {
+
+		got := decodeProperty(&asig.MaterialProperty{TypeInfo: tt.typeInfo, Data: tt.data})
+
+		//NaN never equals itself, so it is only checked through the JSON
+		if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: decodeProperty() = %#v, want %#v", tt.name, got, tt.want)
+		}
+
+		gotJSON, err := json.Marshal(got)
+		if err != nil {
+			t.Errorf("%s: encoding %#v as JSON failed: %v", tt.name, got, err)
+			continue
+		}
+
+		if string(gotJSON) != tt.wantJSON {
+			t.Errorf("%s: JSON of decodeProperty() = %s, want %s", tt.name, gotJSON, tt.wantJSON)
+		}
+	}
+}
+
+//Every float in the scene info must survive JSON encoding, including the ones that aren't material properties
+func TestSceneInfoJSONNonFinite(t *testing.T) {
+
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+
+	info := &sceneInfo{
+		Metadata: []metadataInfo{
+			{Key: "f32", Type: "Float32", Value: jsonFloat32(nan)},
+			{Key: "f64", Type: "Float64", Value: jsonFloat64(math.Inf(-1))},
+		},
+		RootNode: &nodeInfo{Transform: [4][4]jsonFloat32{{jsonFloat32(nan)}}},
+		Meshes:   []*meshInfo{{AABBMin: [3]jsonFloat32{jsonFloat32(inf)}, AABBMax: [3]jsonFloat32{jsonFloat32(-inf)}}},
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("encoding the scene info as JSON failed: %v", err)
+	}
+
+	decoded := struct {
+		Metadata []struct{ Value interface{} }
+		RootNode struct{ Transform [4][4]interface{} }
+		Meshes   []struct{ AABBMin, AABBMax [3]interface{} }
+	}{}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("decoding %s failed: %v", data, err)
+	}
+
+	if got := decoded.Metadata[0].Value; got != "NaN" {
+		t.Errorf("float32 metadata = %#v, want \"NaN\"", got)
+	}
+
+	if got := decoded.Metadata[1].Value; got != "-Inf" {
+		t.Errorf("float64 metadata = %#v, want \"-Inf\"", got)
+	}
+
+	if got := decoded.RootNode.Transform[0]; got[0] != "NaN" || got[1] != float64(0) {
+		t.Errorf("transform row = %#v, want \"NaN\" followed by 0", got)
+	}
+
+	if got := decoded.Meshes[0]; got.AABBMin[0] != "+Inf" || got.AABBMax[0] != "-Inf" {
+		t.Errorf("aabb = %#v to %#v, want \"+Inf\" to \"-Inf\"", got.AABBMin, got.AABBMax)
+	}
+}
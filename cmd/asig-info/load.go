@@ -0,0 +1,9 @@
+//go:build !asig_dynamic
+// +build !asig_dynamic
+
+package main
+
+//loadLibrary does nothing, as assimp is linked into the binary
+func loadLibrary(path string) error {
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build asig_dynamic
+// +build asig_dynamic
+
+package main
+
+import (
+	"errors"
+
+	"github.com/bloeys/assimp-go/asig"
+)
+
+func loadLibrary(path string) error {
+
+	if path == "" {
+		return errors.New("asig-info was built with asig_dynamic, so the assimp library must be passed with -lib")
+	}
+
+	return asig.LoadLibrary(path)
+}
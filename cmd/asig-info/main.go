@@ -0,0 +1,63 @@
+//asig-info prints the contents of a model file: the node tree, meshes, materials, embedded textures, scene flags and metadata.
+//
+//Usage:
+//
+//	asig-info [-json] [-pp flags] [-lib path] <model file>
+//
+//JSON has no NaN or infinity, so -json writes those floats as the strings "NaN", "+Inf" and "-Inf".
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/bloeys/assimp-go/asig"
+)
+
+func main() {
+
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: asig-info [-json] [-pp flags] [-lib path] <model file>")
+		flag.PrintDefaults()
+	}
+
+	jsonOut := flag.Bool("json", false, "print the info as JSON")
+	ppFlags := flag.Uint64("pp", 0, "post processing flags to import with, as a combination of asig.PostProcess values (e.g. 0x8 for triangulate)")
+	libPath := flag.String("lib", "", "path of the assimp shared library to load. Only used when built with the asig_dynamic tag")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := loadLibrary(*libPath); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	file := flag.Arg(0)
+	scene, release, err := asig.ImportFile(file, asig.PostProcess(*ppFlags))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer release()
+
+	info := newSceneInfo(file, scene)
+	if *jsonOut {
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(info); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	printSceneInfo(os.Stdout, info)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+func printSceneInfo(w io.Writer, info *sceneInfo) {
+
+	fmt.Fprintf(w, "File: %s\n", info.File)
+	fmt.Fprintf(w, "Flags: %s\n", info.Flags)
+	fmt.Fprintf(w, "Animations: %d, Lights: %d, Cameras: %d\n", info.Animations, info.Lights, info.Cameras)
+
+	fmt.Fprintf(w, "\nMetadata (%d):\n", len(info.Metadata))
+	printMetadata(w, info.Metadata, "  ")
+
+	fmt.Fprintln(w, "\nNodes:")
+	if info.RootNode != nil {
+		printNode(w, info.RootNode, "  ")
+	}
+
+	fmt.Fprintf(w, "\nMeshes (%d):\n", len(info.Meshes))
+	for i, m := range info.Meshes {
+		fmt.Fprintf(w, "  [%d] '%s': %d vertices, %d faces (%s), %d bones, %d anim meshes\n", i, m.Name, m.Vertices, m.Faces, m.PrimitiveTypes, m.Bones, m.AnimMeshes)
+		fmt.Fprintf(w, "      Material: %d ('%s')\n", m.MaterialIndex, m.MaterialName)
+		fmt.Fprintf(w, "      Channels: %s\n", strings.Join(m.Channels, ", "))
+		fmt.Fprintf(w, "      AABB: min %v, max %v\n", m.AABBMin, m.AABBMax)
+	}
+
+	fmt.Fprintf(w, "\nMaterials (%d):\n", len(info.Materials))
+	for i, m := range info.Materials {
+
+		fmt.Fprintf(w, "  [%d] '%s':\n", i, m.Name)
+		for _, p := range m.Properties {
+
+			key := p.Key
+			if p.Semantic != "" {
+				key = fmt.Sprintf("%s (%s, %d)", p.Key, p.Semantic, p.Index)
+			}
+
+			fmt.Fprintf(w, "      %s [%s] = %s\n", key, p.Type, formatValue(p.Value))
+		}
+	}
+
+	fmt.Fprintf(w, "\nEmbedded textures (%d):\n", len(info.Textures))
+	for i, t := range info.Textures {
+
+		if t.Compressed {
+			fmt.Fprintf(w, "  [%d] *%d '%s': compressed, format hint '%s', %d bytes\n", i, i, t.Filename, t.FormatHint, t.Size)
+			continue
+		}
+
+		fmt.Fprintf(w, "  [%d] *%d '%s': %dx%d, format hint '%s', %d bytes\n", i, i, t.Filename, t.Width, t.Height, t.FormatHint, t.Size)
+	}
+}
+
+func printMetadata(w io.Writer, meta []metadataInfo, indent string) {
+	for _, md := range meta {
+		fmt.Fprintf(w, "%s%s [%s] = %s\n", indent, md.Key, md.Type, formatValue(md.Value))
+	}
+}
+
+func printNode(w io.Writer, n *nodeInfo, indent string) {
+
+	fmt.Fprintf(w, "%s%s", indent, n.Name)
+	if len(n.Meshes) > 0 {
+		fmt.Fprintf(w, " meshes=%v", n.Meshes)
+	}
+	fmt.Fprintln(w)
+
+	if isIdentity(&n.Transform) {
+		fmt.Fprintf(w, "%s  transform: identity\n", indent)
+	} else {
+		fmt.Fprintf(w, "%s  transform:\n", indent)
+		for _, row := range n.Transform {
+			fmt.Fprintf(w, "%s    %v\n", indent, row)
+		}
+	}
+
+	if len(n.Metadata) > 0 {
+		fmt.Fprintf(w, "%s  metadata:\n", indent)
+		printMetadata(w, n.Metadata, indent+"    ")
+	}
+
+	for _, c := range n.Children {
+		printNode(w, c, indent+"  ")
+	}
+}
+
+func isIdentity(m *[4][4]jsonFloat32) bool {
+
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+
+			expected := jsonFloat32(0)
+			if row == col {
+				expected = 1
+			}
+
+			if m[row][col] != expected {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func formatValue(v interface{}) string {
+
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
-	"bytes"
 	"fmt"
-	"image/png"
 
 	"github.com/bloeys/assimp-go/asig"
+
+	//Decoders for compressed embedded textures
+	_ "image/jpeg"
+	_ "image/png"
 )
 
 func main() {
@@ -50,18 +52,12 @@ func main() {
 
 		fmt.Printf("T(%v): Name=%v, Hint=%v, Width=%v, Height=%v, NumTexels=%v\n", i, t.Filename, t.FormatHint, t.Width, t.Height, len(t.Data))
 
-		if t.FormatHint == "png" {
-			decodePNG(t.Data)
+		img, err := t.Image()
+		if err != nil {
+			fmt.Printf("T(%v): Failed to decode: %v\n", i, err)
+			continue
 		}
-	}
-}
 
-func decodePNG(texels []byte) {
-
-	img, err := png.Decode(bytes.NewReader(texels))
-	if err != nil {
-		panic("wow2: " + err.Error())
+		fmt.Printf("T(%v): Decoded %v image\n", i, img.Bounds().Size())
 	}
-
-	println("C:", img.At(100, 100))
 }